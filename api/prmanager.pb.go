@@ -0,0 +1,4061 @@
+// gRPC API of the PR reviewer manager. It mirrors the HTTP API: every rpc
+// calls the same usecase method as its HTTP route and fields are named after
+// the JSON ones. Times are RFC 3339 strings, as in JSON.
+//
+// Errors carry the HTTP error code (e.g. "PR_EXISTS") as the reason of a
+// google.rpc.ErrorInfo detail; a violated merge policy also lists its rules
+// in a google.rpc.PreconditionFailure detail.
+//
+// The caller is taken from the "x-actor-id" metadata, like the X-Actor-Id
+// HTTP header.
+//
+// Regenerate with:
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//     --go-grpc_out=. --go-grpc_opt=paths=source_relative api/prmanager.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/prmanager.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Team struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TeamName            string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewerStrategy    string                 `protobuf:"bytes,2,opt,name=reviewer_strategy,json=reviewerStrategy,proto3" json:"reviewer_strategy,omitempty"`
+	MinReviewers        *int32                 `protobuf:"varint,3,opt,name=min_reviewers,json=minReviewers,proto3,oneof" json:"min_reviewers,omitempty"`
+	MaxReviewers        *int32                 `protobuf:"varint,4,opt,name=max_reviewers,json=maxReviewers,proto3,oneof" json:"max_reviewers,omitempty"`
+	RequiredApprovals   *int32                 `protobuf:"varint,5,opt,name=required_approvals,json=requiredApprovals,proto3,oneof" json:"required_approvals,omitempty"`
+	MergePolicy         *MergePolicy           `protobuf:"bytes,6,opt,name=merge_policy,json=mergePolicy,proto3" json:"merge_policy,omitempty"`
+	ReassignPool        string                 `protobuf:"bytes,7,opt,name=reassign_pool,json=reassignPool,proto3" json:"reassign_pool,omitempty"`
+	PairingLookbackDays *int32                 `protobuf:"varint,8,opt,name=pairing_lookback_days,json=pairingLookbackDays,proto3,oneof" json:"pairing_lookback_days,omitempty"`
+	PartnerTeams        []string               `protobuf:"bytes,9,rep,name=partner_teams,json=partnerTeams,proto3" json:"partner_teams,omitempty"`
+	Members             []*Member              `protobuf:"bytes,10,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_api_prmanager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetReviewerStrategy() string {
+	if x != nil {
+		return x.ReviewerStrategy
+	}
+	return ""
+}
+
+func (x *Team) GetMinReviewers() int32 {
+	if x != nil && x.MinReviewers != nil {
+		return *x.MinReviewers
+	}
+	return 0
+}
+
+func (x *Team) GetMaxReviewers() int32 {
+	if x != nil && x.MaxReviewers != nil {
+		return *x.MaxReviewers
+	}
+	return 0
+}
+
+func (x *Team) GetRequiredApprovals() int32 {
+	if x != nil && x.RequiredApprovals != nil {
+		return *x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *Team) GetMergePolicy() *MergePolicy {
+	if x != nil {
+		return x.MergePolicy
+	}
+	return nil
+}
+
+func (x *Team) GetReassignPool() string {
+	if x != nil {
+		return x.ReassignPool
+	}
+	return ""
+}
+
+func (x *Team) GetPairingLookbackDays() int32 {
+	if x != nil && x.PairingLookbackDays != nil {
+		return *x.PairingLookbackDays
+	}
+	return 0
+}
+
+func (x *Team) GetPartnerTeams() []string {
+	if x != nil {
+		return x.PartnerTeams
+	}
+	return nil
+}
+
+func (x *Team) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MergePolicy struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RequireReviewer    bool                   `protobuf:"varint,1,opt,name=require_reviewer,json=requireReviewer,proto3" json:"require_reviewer,omitempty"`
+	NoChangesRequested bool                   `protobuf:"varint,2,opt,name=no_changes_requested,json=noChangesRequested,proto3" json:"no_changes_requested,omitempty"`
+	// The only approver of a pull request cannot merge it.
+	NoSelfApproval bool `protobuf:"varint,3,opt,name=no_self_approval,json=noSelfApproval,proto3" json:"no_self_approval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MergePolicy) Reset() {
+	*x = MergePolicy{}
+	mi := &file_api_prmanager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePolicy) ProtoMessage() {}
+
+func (x *MergePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePolicy.ProtoReflect.Descriptor instead.
+func (*MergePolicy) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MergePolicy) GetRequireReviewer() bool {
+	if x != nil {
+		return x.RequireReviewer
+	}
+	return false
+}
+
+func (x *MergePolicy) GetNoChangesRequested() bool {
+	if x != nil {
+		return x.NoChangesRequested
+	}
+	return false
+}
+
+func (x *MergePolicy) GetNoSelfApproval() bool {
+	if x != nil {
+		return x.NoSelfApproval
+	}
+	return false
+}
+
+type Member struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive       bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ReviewWeight   int32                  `protobuf:"varint,4,opt,name=review_weight,json=reviewWeight,proto3" json:"review_weight,omitempty"`
+	Tags           []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	MaxOpenReviews *int32                 `protobuf:"varint,6,opt,name=max_open_reviews,json=maxOpenReviews,proto3,oneof" json:"max_open_reviews,omitempty"`
+	// open_reviews is only filled in responses.
+	OpenReviews   *int32 `protobuf:"varint,7,opt,name=open_reviews,json=openReviews,proto3,oneof" json:"open_reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_api_prmanager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Member) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Member) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Member) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Member) GetReviewWeight() int32 {
+	if x != nil {
+		return x.ReviewWeight
+	}
+	return 0
+}
+
+func (x *Member) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Member) GetMaxOpenReviews() int32 {
+	if x != nil && x.MaxOpenReviews != nil {
+		return *x.MaxOpenReviews
+	}
+	return 0
+}
+
+func (x *Member) GetOpenReviews() int32 {
+	if x != nil && x.OpenReviews != nil {
+		return *x.OpenReviews
+	}
+	return 0
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetReviewerStrategyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TeamName         string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewerStrategy string                 `protobuf:"bytes,2,opt,name=reviewer_strategy,json=reviewerStrategy,proto3" json:"reviewer_strategy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetReviewerStrategyRequest) Reset() {
+	*x = SetReviewerStrategyRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReviewerStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReviewerStrategyRequest) ProtoMessage() {}
+
+func (x *SetReviewerStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReviewerStrategyRequest.ProtoReflect.Descriptor instead.
+func (*SetReviewerStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetReviewerStrategyRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetReviewerStrategyRequest) GetReviewerStrategy() string {
+	if x != nil {
+		return x.ReviewerStrategy
+	}
+	return ""
+}
+
+type SetReviewersCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MinReviewers  int32                  `protobuf:"varint,2,opt,name=min_reviewers,json=minReviewers,proto3" json:"min_reviewers,omitempty"`
+	MaxReviewers  int32                  `protobuf:"varint,3,opt,name=max_reviewers,json=maxReviewers,proto3" json:"max_reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReviewersCountRequest) Reset() {
+	*x = SetReviewersCountRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReviewersCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReviewersCountRequest) ProtoMessage() {}
+
+func (x *SetReviewersCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReviewersCountRequest.ProtoReflect.Descriptor instead.
+func (*SetReviewersCountRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetReviewersCountRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetReviewersCountRequest) GetMinReviewers() int32 {
+	if x != nil {
+		return x.MinReviewers
+	}
+	return 0
+}
+
+func (x *SetReviewersCountRequest) GetMaxReviewers() int32 {
+	if x != nil {
+		return x.MaxReviewers
+	}
+	return 0
+}
+
+type SetRequiredApprovalsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,2,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetRequiredApprovalsRequest) Reset() {
+	*x = SetRequiredApprovalsRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRequiredApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRequiredApprovalsRequest) ProtoMessage() {}
+
+func (x *SetRequiredApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRequiredApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*SetRequiredApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetRequiredApprovalsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetRequiredApprovalsRequest) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+type SetMergePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MergePolicy   *MergePolicy           `protobuf:"bytes,2,opt,name=merge_policy,json=mergePolicy,proto3" json:"merge_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMergePolicyRequest) Reset() {
+	*x = SetMergePolicyRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMergePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMergePolicyRequest) ProtoMessage() {}
+
+func (x *SetMergePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMergePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetMergePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetMergePolicyRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetMergePolicyRequest) GetMergePolicy() *MergePolicy {
+	if x != nil {
+		return x.MergePolicy
+	}
+	return nil
+}
+
+type SetReassignPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReassignPool  string                 `protobuf:"bytes,2,opt,name=reassign_pool,json=reassignPool,proto3" json:"reassign_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReassignPoolRequest) Reset() {
+	*x = SetReassignPoolRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReassignPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReassignPoolRequest) ProtoMessage() {}
+
+func (x *SetReassignPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReassignPoolRequest.ProtoReflect.Descriptor instead.
+func (*SetReassignPoolRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetReassignPoolRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetReassignPoolRequest) GetReassignPool() string {
+	if x != nil {
+		return x.ReassignPool
+	}
+	return ""
+}
+
+type SetPairingLookbackRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TeamName            string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PairingLookbackDays int32                  `protobuf:"varint,2,opt,name=pairing_lookback_days,json=pairingLookbackDays,proto3" json:"pairing_lookback_days,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetPairingLookbackRequest) Reset() {
+	*x = SetPairingLookbackRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPairingLookbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPairingLookbackRequest) ProtoMessage() {}
+
+func (x *SetPairingLookbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPairingLookbackRequest.ProtoReflect.Descriptor instead.
+func (*SetPairingLookbackRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetPairingLookbackRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetPairingLookbackRequest) GetPairingLookbackDays() int32 {
+	if x != nil {
+		return x.PairingLookbackDays
+	}
+	return 0
+}
+
+type SetPartnerTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PartnerTeams  []string               `protobuf:"bytes,2,rep,name=partner_teams,json=partnerTeams,proto3" json:"partner_teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPartnerTeamsRequest) Reset() {
+	*x = SetPartnerTeamsRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPartnerTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartnerTeamsRequest) ProtoMessage() {}
+
+func (x *SetPartnerTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPartnerTeamsRequest.ProtoReflect.Descriptor instead.
+func (*SetPartnerTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetPartnerTeamsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetPartnerTeamsRequest) GetPartnerTeams() []string {
+	if x != nil {
+		return x.PartnerTeams
+	}
+	return nil
+}
+
+type UpdateTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*Member              `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	ReviewsPolicy string                 `protobuf:"bytes,3,opt,name=reviews_policy,json=reviewsPolicy,proto3" json:"reviews_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMembersRequest) Reset() {
+	*x = UpdateTeamMembersRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMembersRequest) ProtoMessage() {}
+
+func (x *UpdateTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UpdateTeamMembersRequest) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *UpdateTeamMembersRequest) GetReviewsPolicy() string {
+	if x != nil {
+		return x.ReviewsPolicy
+	}
+	return ""
+}
+
+type RemoveTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ReviewsPolicy string                 `protobuf:"bytes,3,opt,name=reviews_policy,json=reviewsPolicy,proto3" json:"reviews_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMembersRequest) Reset() {
+	*x = RemoveTeamMembersRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMembersRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RemoveTeamMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *RemoveTeamMembersRequest) GetReviewsPolicy() string {
+	if x != nil {
+		return x.ReviewsPolicy
+	}
+	return ""
+}
+
+type MoveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewsPolicy string                 `protobuf:"bytes,3,opt,name=reviews_policy,json=reviewsPolicy,proto3" json:"reviews_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUserRequest) Reset() {
+	*x = MoveUserRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserRequest) ProtoMessage() {}
+
+func (x *MoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserRequest.ProtoReflect.Descriptor instead.
+func (*MoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveUserRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *MoveUserRequest) GetReviewsPolicy() string {
+	if x != nil {
+		return x.ReviewsPolicy
+	}
+	return ""
+}
+
+type ReviewerChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,3,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerChange) Reset() {
+	*x = ReviewerChange{}
+	mi := &file_api_prmanager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerChange) ProtoMessage() {}
+
+func (x *ReviewerChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerChange.ProtoReflect.Descriptor instead.
+func (*ReviewerChange) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReviewerChange) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReviewerChange) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerChange) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type TeamMembersUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Reassigned    []*ReviewerChange      `protobuf:"bytes,2,rep,name=reassigned,proto3" json:"reassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMembersUpdated) Reset() {
+	*x = TeamMembersUpdated{}
+	mi := &file_api_prmanager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMembersUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMembersUpdated) ProtoMessage() {}
+
+func (x *TeamMembersUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMembersUpdated.ProtoReflect.Descriptor instead.
+func (*TeamMembersUpdated) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamMembersUpdated) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamMembersUpdated) GetReassigned() []*ReviewerChange {
+	if x != nil {
+		return x.Reassigned
+	}
+	return nil
+}
+
+type OwnershipRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int32                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Owners        []string               `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipRule) Reset() {
+	*x = OwnershipRule{}
+	mi := &file_api_prmanager_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipRule) ProtoMessage() {}
+
+func (x *OwnershipRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipRule.ProtoReflect.Descriptor instead.
+func (*OwnershipRule) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OwnershipRule) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *OwnershipRule) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *OwnershipRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *OwnershipRule) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type OwnershipRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rules         []*OwnershipRule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipRules) Reset() {
+	*x = OwnershipRules{}
+	mi := &file_api_prmanager_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipRules) ProtoMessage() {}
+
+func (x *OwnershipRules) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipRules.ProtoReflect.Descriptor instead.
+func (*OwnershipRules) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OwnershipRules) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *OwnershipRules) GetRules() []*OwnershipRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteOwnershipRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int32                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOwnershipRuleRequest) Reset() {
+	*x = DeleteOwnershipRuleRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOwnershipRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOwnershipRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOwnershipRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOwnershipRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOwnershipRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteOwnershipRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type User struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	TeamName       string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive       bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Tags           []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	MaxOpenReviews *int32                 `protobuf:"varint,6,opt,name=max_open_reviews,json=maxOpenReviews,proto3,oneof" json:"max_open_reviews,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_prmanager_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetMaxOpenReviews() int32 {
+	if x != nil && x.MaxOpenReviews != nil {
+		return *x.MaxOpenReviews
+	}
+	return 0
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTagsRequest) Reset() {
+	*x = UserTagsRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTagsRequest) ProtoMessage() {}
+
+func (x *UserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTagsRequest.ProtoReflect.Descriptor instead.
+func (*UserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetMaxOpenReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// unset removes the limit
+	MaxOpenReviews *int32 `protobuf:"varint,2,opt,name=max_open_reviews,json=maxOpenReviews,proto3,oneof" json:"max_open_reviews,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMaxOpenReviewsRequest) Reset() {
+	*x = SetMaxOpenReviewsRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxOpenReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxOpenReviewsRequest) ProtoMessage() {}
+
+func (x *SetMaxOpenReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxOpenReviewsRequest.ProtoReflect.Descriptor instead.
+func (*SetMaxOpenReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetMaxOpenReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMaxOpenReviewsRequest) GetMaxOpenReviews() int32 {
+	if x != nil && x.MaxOpenReviews != nil {
+		return *x.MaxOpenReviews
+	}
+	return 0
+}
+
+type UserContacts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlackWebhookUrl string                 `protobuf:"bytes,2,opt,name=slack_webhook_url,json=slackWebhookUrl,proto3" json:"slack_webhook_url,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserContacts) Reset() {
+	*x = UserContacts{}
+	mi := &file_api_prmanager_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserContacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserContacts) ProtoMessage() {}
+
+func (x *UserContacts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserContacts.ProtoReflect.Descriptor instead.
+func (*UserContacts) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserContacts) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserContacts) GetSlackWebhookUrl() string {
+	if x != nil {
+		return x.SlackWebhookUrl
+	}
+	return ""
+}
+
+func (x *UserContacts) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Unavailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodId      int32                  `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unavailability) Reset() {
+	*x = Unavailability{}
+	mi := &file_api_prmanager_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unavailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unavailability) ProtoMessage() {}
+
+func (x *Unavailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unavailability.ProtoReflect.Descriptor instead.
+func (*Unavailability) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Unavailability) GetPeriodId() int32 {
+	if x != nil {
+		return x.PeriodId
+	}
+	return 0
+}
+
+func (x *Unavailability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Unavailability) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Unavailability) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Unavailability) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UserUnavailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Periods       []*Unavailability      `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUnavailability) Reset() {
+	*x = UserUnavailability{}
+	mi := &file_api_prmanager_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUnavailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUnavailability) ProtoMessage() {}
+
+func (x *UserUnavailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUnavailability.ProtoReflect.Descriptor instead.
+func (*UserUnavailability) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserUnavailability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserUnavailability) GetPeriods() []*Unavailability {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type RemoveUnavailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodId      int32                  `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUnavailabilityRequest) Reset() {
+	*x = RemoveUnavailabilityRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUnavailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUnavailabilityRequest) ProtoMessage() {}
+
+func (x *RemoveUnavailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUnavailabilityRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUnavailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveUnavailabilityRequest) GetPeriodId() int32 {
+	if x != nil {
+		return x.PeriodId
+	}
+	return 0
+}
+
+type BulkDeactivateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeactivateRequest) Reset() {
+	*x = BulkDeactivateRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeactivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeactivateRequest) ProtoMessage() {}
+
+func (x *BulkDeactivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeactivateRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeactivateRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkDeactivateRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BulkDeactivateRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type BulkDeactivateResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeactivatedUsers []string               `protobuf:"bytes,1,rep,name=deactivated_users,json=deactivatedUsers,proto3" json:"deactivated_users,omitempty"`
+	Reassigned       []*ReviewerChange      `protobuf:"bytes,2,rep,name=reassigned,proto3" json:"reassigned,omitempty"`
+	Dropped          []*ReviewerChange      `protobuf:"bytes,3,rep,name=dropped,proto3" json:"dropped,omitempty"`
+	WithoutReviewers []string               `protobuf:"bytes,4,rep,name=without_reviewers,json=withoutReviewers,proto3" json:"without_reviewers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BulkDeactivateResult) Reset() {
+	*x = BulkDeactivateResult{}
+	mi := &file_api_prmanager_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeactivateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeactivateResult) ProtoMessage() {}
+
+func (x *BulkDeactivateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeactivateResult.ProtoReflect.Descriptor instead.
+func (*BulkDeactivateResult) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkDeactivateResult) GetDeactivatedUsers() []string {
+	if x != nil {
+		return x.DeactivatedUsers
+	}
+	return nil
+}
+
+func (x *BulkDeactivateResult) GetReassigned() []*ReviewerChange {
+	if x != nil {
+		return x.Reassigned
+	}
+	return nil
+}
+
+func (x *BulkDeactivateResult) GetDropped() []*ReviewerChange {
+	if x != nil {
+		return x.Dropped
+	}
+	return nil
+}
+
+func (x *BulkDeactivateResult) GetWithoutReviewers() []string {
+	if x != nil {
+		return x.WithoutReviewers
+	}
+	return nil
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_api_prmanager_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Decision        string                 `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	DecidedAt       string                 `protobuf:"bytes,6,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_api_prmanager_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewersCount  *int32                 `protobuf:"varint,4,opt,name=reviewers_count,json=reviewersCount,proto3,oneof" json:"reviewers_count,omitempty"`
+	Draft           bool                   `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	ChangedFiles    []string               `protobuf:"bytes,6,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	RequiredTags    []string               `protobuf:"bytes,7,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetReviewersCount() int32 {
+	if x != nil && x.ReviewersCount != nil {
+		return *x.ReviewersCount
+	}
+	return 0
+}
+
+func (x *CreatePullRequestRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type ReviewerPool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Pool          string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MatchedRule   string                 `protobuf:"bytes,4,opt,name=matched_rule,json=matchedRule,proto3" json:"matched_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerPool) Reset() {
+	*x = ReviewerPool{}
+	mi := &file_api_prmanager_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerPool) ProtoMessage() {}
+
+func (x *ReviewerPool) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerPool.ProtoReflect.Descriptor instead.
+func (*ReviewerPool) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReviewerPool) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerPool) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ReviewerPool) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ReviewerPool) GetMatchedRule() string {
+	if x != nil {
+		return x.MatchedRule
+	}
+	return ""
+}
+
+type CreatedPullRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers  []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ReviewerPools      []*ReviewerPool        `protobuf:"bytes,6,rep,name=reviewer_pools,json=reviewerPools,proto3" json:"reviewer_pools,omitempty"`
+	RequiredReviewers  int32                  `protobuf:"varint,7,opt,name=required_reviewers,json=requiredReviewers,proto3" json:"required_reviewers,omitempty"`
+	NotEnoughReviewers bool                   `protobuf:"varint,8,opt,name=not_enough_reviewers,json=notEnoughReviewers,proto3" json:"not_enough_reviewers,omitempty"`
+	CapacityLimited    bool                   `protobuf:"varint,9,opt,name=capacity_limited,json=capacityLimited,proto3" json:"capacity_limited,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatedPullRequest) Reset() {
+	*x = CreatedPullRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedPullRequest) ProtoMessage() {}
+
+func (x *CreatedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedPullRequest.ProtoReflect.Descriptor instead.
+func (*CreatedPullRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreatedPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatedPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatedPullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatedPullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreatedPullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *CreatedPullRequest) GetReviewerPools() []*ReviewerPool {
+	if x != nil {
+		return x.ReviewerPools
+	}
+	return nil
+}
+
+func (x *CreatedPullRequest) GetRequiredReviewers() int32 {
+	if x != nil {
+		return x.RequiredReviewers
+	}
+	return 0
+}
+
+func (x *CreatedPullRequest) GetNotEnoughReviewers() bool {
+	if x != nil {
+		return x.NotEnoughReviewers
+	}
+	return false
+}
+
+func (x *CreatedPullRequest) GetCapacityLimited() bool {
+	if x != nil {
+		return x.CapacityLimited
+	}
+	return false
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MergePullRequestRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReviewerDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	DecidedAt     string                 `protobuf:"bytes,3,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerDecision) Reset() {
+	*x = ReviewerDecision{}
+	mi := &file_api_prmanager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerDecision) ProtoMessage() {}
+
+func (x *ReviewerDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerDecision.ProtoReflect.Descriptor instead.
+func (*ReviewerDecision) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReviewerDecision) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerDecision) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewerDecision) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type MergedPullRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers  []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	MergedAt           string                 `protobuf:"bytes,6,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	RequiredReviewers  int32                  `protobuf:"varint,7,opt,name=required_reviewers,json=requiredReviewers,proto3" json:"required_reviewers,omitempty"`
+	NotEnoughReviewers bool                   `protobuf:"varint,8,opt,name=not_enough_reviewers,json=notEnoughReviewers,proto3" json:"not_enough_reviewers,omitempty"`
+	Reviews            []*ReviewerDecision    `protobuf:"bytes,9,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Approvals          int32                  `protobuf:"varint,10,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals  int32                  `protobuf:"varint,11,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	Forced             bool                   `protobuf:"varint,12,opt,name=forced,proto3" json:"forced,omitempty"`
+	BypassedRules      []string               `protobuf:"bytes,13,rep,name=bypassed_rules,json=bypassedRules,proto3" json:"bypassed_rules,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MergedPullRequest) Reset() {
+	*x = MergedPullRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergedPullRequest) ProtoMessage() {}
+
+func (x *MergedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergedPullRequest.ProtoReflect.Descriptor instead.
+func (*MergedPullRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MergedPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MergedPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *MergedPullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *MergedPullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MergedPullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *MergedPullRequest) GetMergedAt() string {
+	if x != nil {
+		return x.MergedAt
+	}
+	return ""
+}
+
+func (x *MergedPullRequest) GetRequiredReviewers() int32 {
+	if x != nil {
+		return x.RequiredReviewers
+	}
+	return 0
+}
+
+func (x *MergedPullRequest) GetNotEnoughReviewers() bool {
+	if x != nil {
+		return x.NotEnoughReviewers
+	}
+	return false
+}
+
+func (x *MergedPullRequest) GetReviews() []*ReviewerDecision {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *MergedPullRequest) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *MergedPullRequest) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *MergedPullRequest) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *MergedPullRequest) GetBypassedRules() []string {
+	if x != nil {
+		return x.BypassedRules
+	}
+	return nil
+}
+
+type ChangeStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStatusRequest) Reset() {
+	*x = ChangeStatusRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStatusRequest) ProtoMessage() {}
+
+func (x *ChangeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChangeStatusRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type PullRequestStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers  []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ReviewerPools      []*ReviewerPool        `protobuf:"bytes,6,rep,name=reviewer_pools,json=reviewerPools,proto3" json:"reviewer_pools,omitempty"`
+	RequiredReviewers  int32                  `protobuf:"varint,7,opt,name=required_reviewers,json=requiredReviewers,proto3" json:"required_reviewers,omitempty"`
+	NotEnoughReviewers bool                   `protobuf:"varint,8,opt,name=not_enough_reviewers,json=notEnoughReviewers,proto3" json:"not_enough_reviewers,omitempty"`
+	CapacityLimited    bool                   `protobuf:"varint,9,opt,name=capacity_limited,json=capacityLimited,proto3" json:"capacity_limited,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PullRequestStatus) Reset() {
+	*x = PullRequestStatus{}
+	mi := &file_api_prmanager_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestStatus) ProtoMessage() {}
+
+func (x *PullRequestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestStatus.ProtoReflect.Descriptor instead.
+func (*PullRequestStatus) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PullRequestStatus) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestStatus) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestStatus) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestStatus) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequestStatus) GetReviewerPools() []*ReviewerPool {
+	if x != nil {
+		return x.ReviewerPools
+	}
+	return nil
+}
+
+func (x *PullRequestStatus) GetRequiredReviewers() int32 {
+	if x != nil {
+		return x.RequiredReviewers
+	}
+	return 0
+}
+
+func (x *PullRequestStatus) GetNotEnoughReviewers() bool {
+	if x != nil {
+		return x.NotEnoughReviewers
+	}
+	return false
+}
+
+func (x *PullRequestStatus) GetCapacityLimited() bool {
+	if x != nil {
+		return x.CapacityLimited
+	}
+	return false
+}
+
+type ReassignRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId       string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId       string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	RequestedReviewerId string                 `protobuf:"bytes,3,opt,name=requested_reviewer_id,json=requestedReviewerId,proto3" json:"requested_reviewer_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReassignRequest) Reset() {
+	*x = ReassignRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignRequest) ProtoMessage() {}
+
+func (x *ReassignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignRequest.ProtoReflect.Descriptor instead.
+func (*ReassignRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReassignRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignRequest) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *ReassignRequest) GetRequestedReviewerId() string {
+	if x != nil {
+		return x.RequestedReviewerId
+	}
+	return ""
+}
+
+type ReassignedPullRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers  []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ReplacedBy         string                 `protobuf:"bytes,6,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	RequiredReviewers  int32                  `protobuf:"varint,7,opt,name=required_reviewers,json=requiredReviewers,proto3" json:"required_reviewers,omitempty"`
+	NotEnoughReviewers bool                   `protobuf:"varint,8,opt,name=not_enough_reviewers,json=notEnoughReviewers,proto3" json:"not_enough_reviewers,omitempty"`
+	CapacityLimited    bool                   `protobuf:"varint,9,opt,name=capacity_limited,json=capacityLimited,proto3" json:"capacity_limited,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReassignedPullRequest) Reset() {
+	*x = ReassignedPullRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignedPullRequest) ProtoMessage() {}
+
+func (x *ReassignedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignedPullRequest.ProtoReflect.Descriptor instead.
+func (*ReassignedPullRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReassignedPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignedPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *ReassignedPullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReassignedPullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReassignedPullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *ReassignedPullRequest) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *ReassignedPullRequest) GetRequiredReviewers() int32 {
+	if x != nil {
+		return x.RequiredReviewers
+	}
+	return 0
+}
+
+func (x *ReassignedPullRequest) GetNotEnoughReviewers() bool {
+	if x != nil {
+		return x.NotEnoughReviewers
+	}
+	return false
+}
+
+func (x *ReassignedPullRequest) GetCapacityLimited() bool {
+	if x != nil {
+		return x.CapacityLimited
+	}
+	return false
+}
+
+type ChangeReviewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerIds   []string               `protobuf:"bytes,2,rep,name=reviewer_ids,json=reviewerIds,proto3" json:"reviewer_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeReviewersRequest) Reset() {
+	*x = ChangeReviewersRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeReviewersRequest) ProtoMessage() {}
+
+func (x *ChangeReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeReviewersRequest.ProtoReflect.Descriptor instead.
+func (*ChangeReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChangeReviewersRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ChangeReviewersRequest) GetReviewerIds() []string {
+	if x != nil {
+		return x.ReviewerIds
+	}
+	return nil
+}
+
+type PullRequestReviewers struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers  []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	RequiredReviewers  int32                  `protobuf:"varint,6,opt,name=required_reviewers,json=requiredReviewers,proto3" json:"required_reviewers,omitempty"`
+	NotEnoughReviewers bool                   `protobuf:"varint,7,opt,name=not_enough_reviewers,json=notEnoughReviewers,proto3" json:"not_enough_reviewers,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PullRequestReviewers) Reset() {
+	*x = PullRequestReviewers{}
+	mi := &file_api_prmanager_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestReviewers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestReviewers) ProtoMessage() {}
+
+func (x *PullRequestReviewers) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestReviewers.ProtoReflect.Descriptor instead.
+func (*PullRequestReviewers) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PullRequestReviewers) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestReviewers) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestReviewers) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestReviewers) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestReviewers) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequestReviewers) GetRequiredReviewers() int32 {
+	if x != nil {
+		return x.RequiredReviewers
+	}
+	return 0
+}
+
+func (x *PullRequestReviewers) GetNotEnoughReviewers() bool {
+	if x != nil {
+		return x.NotEnoughReviewers
+	}
+	return false
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SubmitReviewRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+type SubmittedReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	DecidedAt     string                 `protobuf:"bytes,4,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmittedReview) Reset() {
+	*x = SubmittedReview{}
+	mi := &file_api_prmanager_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmittedReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmittedReview) ProtoMessage() {}
+
+func (x *SubmittedReview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmittedReview.ProtoReflect.Descriptor instead.
+func (*SubmittedReview) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SubmittedReview) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SubmittedReview) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *SubmittedReview) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *SubmittedReview) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_api_prmanager_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type PullRequestEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,3,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	NewReviewerId string                 `protobuf:"bytes,4,opt,name=new_reviewer_id,json=newReviewerId,proto3" json:"new_reviewer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SelectionSeed *int64                 `protobuf:"varint,6,opt,name=selection_seed,json=selectionSeed,proto3,oneof" json:"selection_seed,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestEvent) Reset() {
+	*x = PullRequestEvent{}
+	mi := &file_api_prmanager_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestEvent) ProtoMessage() {}
+
+func (x *PullRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestEvent.ProtoReflect.Descriptor instead.
+func (*PullRequestEvent) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PullRequestEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetNewReviewerId() string {
+	if x != nil {
+		return x.NewReviewerId
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetSelectionSeed() int64 {
+	if x != nil && x.SelectionSeed != nil {
+		return *x.SelectionSeed
+	}
+	return 0
+}
+
+func (x *PullRequestEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PullRequestHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Events        []*PullRequestEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestHistory) Reset() {
+	*x = PullRequestHistory{}
+	mi := &file_api_prmanager_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestHistory) ProtoMessage() {}
+
+func (x *PullRequestHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestHistory.ProtoReflect.Descriptor instead.
+func (*PullRequestHistory) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PullRequestHistory) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestHistory) GetEvents() []*PullRequestEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AssignmentCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Selected      bool                   `protobuf:"varint,2,opt,name=selected,proto3" json:"selected,omitempty"`
+	Pool          string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentCandidate) Reset() {
+	*x = AssignmentCandidate{}
+	mi := &file_api_prmanager_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentCandidate) ProtoMessage() {}
+
+func (x *AssignmentCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentCandidate.ProtoReflect.Descriptor instead.
+func (*AssignmentCandidate) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AssignmentCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignmentCandidate) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *AssignmentCandidate) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+type ExcludedCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludedCandidate) Reset() {
+	*x = ExcludedCandidate{}
+	mi := &file_api_prmanager_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludedCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludedCandidate) ProtoMessage() {}
+
+func (x *ExcludedCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludedCandidate.ProtoReflect.Descriptor instead.
+func (*ExcludedCandidate) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExcludedCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExcludedCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AssignmentExplanation struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Trigger            string                 `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Actor              string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Strategy           string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	SelectionSeed      *int64                 `protobuf:"varint,4,opt,name=selection_seed,json=selectionSeed,proto3,oneof" json:"selection_seed,omitempty"`
+	ReplacedReviewerId string                 `protobuf:"bytes,5,opt,name=replaced_reviewer_id,json=replacedReviewerId,proto3" json:"replaced_reviewer_id,omitempty"`
+	SelectedReviewers  []string               `protobuf:"bytes,6,rep,name=selected_reviewers,json=selectedReviewers,proto3" json:"selected_reviewers,omitempty"`
+	Candidates         []*AssignmentCandidate `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Excluded           []*ExcludedCandidate   `protobuf:"bytes,8,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AssignmentExplanation) Reset() {
+	*x = AssignmentExplanation{}
+	mi := &file_api_prmanager_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentExplanation) ProtoMessage() {}
+
+func (x *AssignmentExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentExplanation.ProtoReflect.Descriptor instead.
+func (*AssignmentExplanation) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AssignmentExplanation) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *AssignmentExplanation) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AssignmentExplanation) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AssignmentExplanation) GetSelectionSeed() int64 {
+	if x != nil && x.SelectionSeed != nil {
+		return *x.SelectionSeed
+	}
+	return 0
+}
+
+func (x *AssignmentExplanation) GetReplacedReviewerId() string {
+	if x != nil {
+		return x.ReplacedReviewerId
+	}
+	return ""
+}
+
+func (x *AssignmentExplanation) GetSelectedReviewers() []string {
+	if x != nil {
+		return x.SelectedReviewers
+	}
+	return nil
+}
+
+func (x *AssignmentExplanation) GetCandidates() []*AssignmentCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *AssignmentExplanation) GetExcluded() []*ExcludedCandidate {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+func (x *AssignmentExplanation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AssignmentExplanations struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	PullRequestId string                   `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Assignments   []*AssignmentExplanation `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentExplanations) Reset() {
+	*x = AssignmentExplanations{}
+	mi := &file_api_prmanager_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentExplanations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentExplanations) ProtoMessage() {}
+
+func (x *AssignmentExplanations) ProtoReflect() protoreflect.Message {
+	mi := &file_api_prmanager_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentExplanations.ProtoReflect.Descriptor instead.
+func (*AssignmentExplanations) Descriptor() ([]byte, []int) {
+	return file_api_prmanager_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AssignmentExplanations) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AssignmentExplanations) GetAssignments() []*AssignmentExplanation {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+var File_api_prmanager_proto protoreflect.FileDescriptor
+
+const file_api_prmanager_proto_rawDesc = "" +
+	"\n" +
+	"\x13api/prmanager.proto\x12\fprmanager.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x9e\x04\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12+\n" +
+	"\x11reviewer_strategy\x18\x02 \x01(\tR\x10reviewerStrategy\x12(\n" +
+	"\rmin_reviewers\x18\x03 \x01(\x05H\x00R\fminReviewers\x88\x01\x01\x12(\n" +
+	"\rmax_reviewers\x18\x04 \x01(\x05H\x01R\fmaxReviewers\x88\x01\x01\x122\n" +
+	"\x12required_approvals\x18\x05 \x01(\x05H\x02R\x11requiredApprovals\x88\x01\x01\x12<\n" +
+	"\fmerge_policy\x18\x06 \x01(\v2\x19.prmanager.v1.MergePolicyR\vmergePolicy\x12#\n" +
+	"\rreassign_pool\x18\a \x01(\tR\freassignPool\x127\n" +
+	"\x15pairing_lookback_days\x18\b \x01(\x05H\x03R\x13pairingLookbackDays\x88\x01\x01\x12#\n" +
+	"\rpartner_teams\x18\t \x03(\tR\fpartnerTeams\x12.\n" +
+	"\amembers\x18\n" +
+	" \x03(\v2\x14.prmanager.v1.MemberR\amembersB\x10\n" +
+	"\x0e_min_reviewersB\x10\n" +
+	"\x0e_max_reviewersB\x15\n" +
+	"\x13_required_approvalsB\x18\n" +
+	"\x16_pairing_lookback_days\"\x94\x01\n" +
+	"\vMergePolicy\x12)\n" +
+	"\x10require_reviewer\x18\x01 \x01(\bR\x0frequireReviewer\x120\n" +
+	"\x14no_changes_requested\x18\x02 \x01(\bR\x12noChangesRequested\x12(\n" +
+	"\x10no_self_approval\x18\x03 \x01(\bR\x0enoSelfApproval\"\x90\x02\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12#\n" +
+	"\rreview_weight\x18\x04 \x01(\x05R\freviewWeight\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12-\n" +
+	"\x10max_open_reviews\x18\x06 \x01(\x05H\x00R\x0emaxOpenReviews\x88\x01\x01\x12&\n" +
+	"\fopen_reviews\x18\a \x01(\x05H\x01R\vopenReviews\x88\x01\x01B\x13\n" +
+	"\x11_max_open_reviewsB\x0f\n" +
+	"\r_open_reviews\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"f\n" +
+	"\x1aSetReviewerStrategyRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12+\n" +
+	"\x11reviewer_strategy\x18\x02 \x01(\tR\x10reviewerStrategy\"\x81\x01\n" +
+	"\x18SetReviewersCountRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rmin_reviewers\x18\x02 \x01(\x05R\fminReviewers\x12#\n" +
+	"\rmax_reviewers\x18\x03 \x01(\x05R\fmaxReviewers\"i\n" +
+	"\x1bSetRequiredApprovalsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12-\n" +
+	"\x12required_approvals\x18\x02 \x01(\x05R\x11requiredApprovals\"r\n" +
+	"\x15SetMergePolicyRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12<\n" +
+	"\fmerge_policy\x18\x02 \x01(\v2\x19.prmanager.v1.MergePolicyR\vmergePolicy\"Z\n" +
+	"\x16SetReassignPoolRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rreassign_pool\x18\x02 \x01(\tR\freassignPool\"l\n" +
+	"\x19SetPairingLookbackRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x122\n" +
+	"\x15pairing_lookback_days\x18\x02 \x01(\x05R\x13pairingLookbackDays\"Z\n" +
+	"\x16SetPartnerTeamsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rpartner_teams\x18\x02 \x03(\tR\fpartnerTeams\"\x8e\x01\n" +
+	"\x18UpdateTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12.\n" +
+	"\amembers\x18\x02 \x03(\v2\x14.prmanager.v1.MemberR\amembers\x12%\n" +
+	"\x0ereviews_policy\x18\x03 \x01(\tR\rreviewsPolicy\"y\n" +
+	"\x18RemoveTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12%\n" +
+	"\x0ereviews_policy\x18\x03 \x01(\tR\rreviewsPolicy\"n\n" +
+	"\x0fMoveUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12%\n" +
+	"\x0ereviews_policy\x18\x03 \x01(\tR\rreviewsPolicy\"\x81\x01\n" +
+	"\x0eReviewerChange\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x12\x1f\n" +
+	"\vreplaced_by\x18\x03 \x01(\tR\n" +
+	"replacedBy\"z\n" +
+	"\x12TeamMembersUpdated\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prmanager.v1.TeamR\x04team\x12<\n" +
+	"\n" +
+	"reassigned\x18\x02 \x03(\v2\x1c.prmanager.v1.ReviewerChangeR\n" +
+	"reassigned\"w\n" +
+	"\rOwnershipRule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x16\n" +
+	"\x06owners\x18\x04 \x03(\tR\x06owners\"`\n" +
+	"\x0eOwnershipRules\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\x05rules\x18\x02 \x03(\v2\x1b.prmanager.v1.OwnershipRuleR\x05rules\"5\n" +
+	"\x1aDeleteOwnershipRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\"\xce\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12-\n" +
+	"\x10max_open_reviews\x18\x06 \x01(\x05H\x00R\x0emaxOpenReviews\x88\x01\x01B\x13\n" +
+	"\x11_max_open_reviews\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\">\n" +
+	"\x0fUserTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"w\n" +
+	"\x18SetMaxOpenReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x10max_open_reviews\x18\x02 \x01(\x05H\x00R\x0emaxOpenReviews\x88\x01\x01B\x13\n" +
+	"\x11_max_open_reviews\"i\n" +
+	"\fUserContacts\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x11slack_webhook_url\x18\x02 \x01(\tR\x0fslackWebhookUrl\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"\x94\x01\n" +
+	"\x0eUnavailability\x12\x1b\n" +
+	"\tperiod_id\x18\x01 \x01(\x05R\bperiodId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tstarts_at\x18\x03 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x04 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"e\n" +
+	"\x12UserUnavailability\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x126\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1c.prmanager.v1.UnavailabilityR\aperiods\":\n" +
+	"\x1bRemoveUnavailabilityRequest\x12\x1b\n" +
+	"\tperiod_id\x18\x01 \x01(\x05R\bperiodId\"O\n" +
+	"\x15BulkDeactivateRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"\xe6\x01\n" +
+	"\x14BulkDeactivateResult\x12+\n" +
+	"\x11deactivated_users\x18\x01 \x03(\tR\x10deactivatedUsers\x12<\n" +
+	"\n" +
+	"reassigned\x18\x02 \x03(\v2\x1c.prmanager.v1.ReviewerChangeR\n" +
+	"reassigned\x126\n" +
+	"\adropped\x18\x03 \x03(\v2\x1c.prmanager.v1.ReviewerChangeR\adropped\x12+\n" +
+	"\x11without_reviewers\x18\x04 \x03(\tR\x10withoutReviewers\"f\n" +
+	"\x06Review\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12C\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1e.prmanager.v1.PullRequestShortR\fpullRequests\"\xd6\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bdecision\x18\x05 \x01(\tR\bdecision\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\x06 \x01(\tR\tdecidedAt\"\xad\x02\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12,\n" +
+	"\x0freviewers_count\x18\x04 \x01(\x05H\x00R\x0ereviewersCount\x88\x01\x01\x12\x14\n" +
+	"\x05draft\x18\x05 \x01(\bR\x05draft\x12#\n" +
+	"\rchanged_files\x18\x06 \x03(\tR\fchangedFiles\x12#\n" +
+	"\rrequired_tags\x18\a \x03(\tR\frequiredTagsB\x12\n" +
+	"\x10_reviewers_count\"\x83\x01\n" +
+	"\fReviewerPool\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\tR\x04pool\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12!\n" +
+	"\fmatched_rule\x18\x04 \x01(\tR\vmatchedRule\"\x9b\x03\n" +
+	"\x12CreatedPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12A\n" +
+	"\x0ereviewer_pools\x18\x06 \x03(\v2\x1a.prmanager.v1.ReviewerPoolR\rreviewerPools\x12-\n" +
+	"\x12required_reviewers\x18\a \x01(\x05R\x11requiredReviewers\x120\n" +
+	"\x14not_enough_reviewers\x18\b \x01(\bR\x12notEnoughReviewers\x12)\n" +
+	"\x10capacity_limited\x18\t \x01(\bR\x0fcapacityLimited\"W\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"n\n" +
+	"\x10ReviewerDecision\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\x03 \x01(\tR\tdecidedAt\"\x8f\x04\n" +
+	"\x11MergedPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12\x1b\n" +
+	"\tmerged_at\x18\x06 \x01(\tR\bmergedAt\x12-\n" +
+	"\x12required_reviewers\x18\a \x01(\x05R\x11requiredReviewers\x120\n" +
+	"\x14not_enough_reviewers\x18\b \x01(\bR\x12notEnoughReviewers\x128\n" +
+	"\areviews\x18\t \x03(\v2\x1e.prmanager.v1.ReviewerDecisionR\areviews\x12\x1c\n" +
+	"\tapprovals\x18\n" +
+	" \x01(\x05R\tapprovals\x12-\n" +
+	"\x12required_approvals\x18\v \x01(\x05R\x11requiredApprovals\x12\x16\n" +
+	"\x06forced\x18\f \x01(\bR\x06forced\x12%\n" +
+	"\x0ebypassed_rules\x18\r \x03(\tR\rbypassedRules\"=\n" +
+	"\x13ChangeStatusRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"\x9a\x03\n" +
+	"\x11PullRequestStatus\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12A\n" +
+	"\x0ereviewer_pools\x18\x06 \x03(\v2\x1a.prmanager.v1.ReviewerPoolR\rreviewerPools\x12-\n" +
+	"\x12required_reviewers\x18\a \x01(\x05R\x11requiredReviewers\x120\n" +
+	"\x14not_enough_reviewers\x18\b \x01(\bR\x12notEnoughReviewers\x12)\n" +
+	"\x10capacity_limited\x18\t \x01(\bR\x0fcapacityLimited\"\x95\x01\n" +
+	"\x0fReassignRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x122\n" +
+	"\x15requested_reviewer_id\x18\x03 \x01(\tR\x13requestedReviewerId\"\xfc\x02\n" +
+	"\x15ReassignedPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12\x1f\n" +
+	"\vreplaced_by\x18\x06 \x01(\tR\n" +
+	"replacedBy\x12-\n" +
+	"\x12required_reviewers\x18\a \x01(\x05R\x11requiredReviewers\x120\n" +
+	"\x14not_enough_reviewers\x18\b \x01(\bR\x12notEnoughReviewers\x12)\n" +
+	"\x10capacity_limited\x18\t \x01(\bR\x0fcapacityLimited\"c\n" +
+	"\x16ChangeReviewersRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12!\n" +
+	"\freviewer_ids\x18\x02 \x03(\tR\vreviewerIds\"\xaf\x02\n" +
+	"\x14PullRequestReviewers\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12-\n" +
+	"\x12required_reviewers\x18\x06 \x01(\x05R\x11requiredReviewers\x120\n" +
+	"\x14not_enough_reviewers\x18\a \x01(\bR\x12notEnoughReviewers\"z\n" +
+	"\x13SubmitReviewRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bdecision\x18\x03 \x01(\tR\bdecision\"\x95\x01\n" +
+	"\x0fSubmittedReview\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bdecision\x18\x03 \x01(\tR\bdecision\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\x04 \x01(\tR\tdecidedAt\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"\x8d\x02\n" +
+	"\x10PullRequestEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12&\n" +
+	"\x0fold_reviewer_id\x18\x03 \x01(\tR\roldReviewerId\x12&\n" +
+	"\x0fnew_reviewer_id\x18\x04 \x01(\tR\rnewReviewerId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
+	"\x0eselection_seed\x18\x06 \x01(\x03H\x00R\rselectionSeed\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAtB\x11\n" +
+	"\x0f_selection_seed\"t\n" +
+	"\x12PullRequestHistory\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x126\n" +
+	"\x06events\x18\x02 \x03(\v2\x1e.prmanager.v1.PullRequestEventR\x06events\"^\n" +
+	"\x13AssignmentCandidate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bselected\x18\x02 \x01(\bR\bselected\x12\x12\n" +
+	"\x04pool\x18\x03 \x01(\tR\x04pool\"D\n" +
+	"\x11ExcludedCandidate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xa2\x03\n" +
+	"\x15AssignmentExplanation\x12\x18\n" +
+	"\atrigger\x18\x01 \x01(\tR\atrigger\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x12*\n" +
+	"\x0eselection_seed\x18\x04 \x01(\x03H\x00R\rselectionSeed\x88\x01\x01\x120\n" +
+	"\x14replaced_reviewer_id\x18\x05 \x01(\tR\x12replacedReviewerId\x12-\n" +
+	"\x12selected_reviewers\x18\x06 \x03(\tR\x11selectedReviewers\x12A\n" +
+	"\n" +
+	"candidates\x18\a \x03(\v2!.prmanager.v1.AssignmentCandidateR\n" +
+	"candidates\x12;\n" +
+	"\bexcluded\x18\b \x03(\v2\x1f.prmanager.v1.ExcludedCandidateR\bexcluded\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAtB\x11\n" +
+	"\x0f_selection_seed\"\x87\x01\n" +
+	"\x16AssignmentExplanations\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12E\n" +
+	"\vassignments\x18\x02 \x03(\v2#.prmanager.v1.AssignmentExplanationR\vassignments2\x86\n" +
+	"\n" +
+	"\vTeamService\x121\n" +
+	"\aAddTeam\x12\x12.prmanager.v1.Team\x1a\x12.prmanager.v1.Team\x12;\n" +
+	"\aGetTeam\x12\x1c.prmanager.v1.GetTeamRequest\x1a\x12.prmanager.v1.Team\x12S\n" +
+	"\x13SetReviewerStrategy\x12(.prmanager.v1.SetReviewerStrategyRequest\x1a\x12.prmanager.v1.Team\x12O\n" +
+	"\x11SetReviewersCount\x12&.prmanager.v1.SetReviewersCountRequest\x1a\x12.prmanager.v1.Team\x12U\n" +
+	"\x14SetRequiredApprovals\x12).prmanager.v1.SetRequiredApprovalsRequest\x1a\x12.prmanager.v1.Team\x12I\n" +
+	"\x0eSetMergePolicy\x12#.prmanager.v1.SetMergePolicyRequest\x1a\x12.prmanager.v1.Team\x12K\n" +
+	"\x0fSetReassignPool\x12$.prmanager.v1.SetReassignPoolRequest\x1a\x12.prmanager.v1.Team\x12Q\n" +
+	"\x12SetPairingLookback\x12'.prmanager.v1.SetPairingLookbackRequest\x1a\x12.prmanager.v1.Team\x12K\n" +
+	"\x0fSetPartnerTeams\x12$.prmanager.v1.SetPartnerTeamsRequest\x1a\x12.prmanager.v1.Team\x12]\n" +
+	"\x11UpdateTeamMembers\x12&.prmanager.v1.UpdateTeamMembersRequest\x1a .prmanager.v1.TeamMembersUpdated\x12]\n" +
+	"\x11RemoveTeamMembers\x12&.prmanager.v1.RemoveTeamMembersRequest\x1a .prmanager.v1.TeamMembersUpdated\x12K\n" +
+	"\bMoveUser\x12\x1d.prmanager.v1.MoveUserRequest\x1a .prmanager.v1.TeamMembersUpdated\x12L\n" +
+	"\x10AddOwnershipRule\x12\x1b.prmanager.v1.OwnershipRule\x1a\x1b.prmanager.v1.OwnershipRule\x12O\n" +
+	"\x11GetOwnershipRules\x12\x1c.prmanager.v1.GetTeamRequest\x1a\x1c.prmanager.v1.OwnershipRules\x12O\n" +
+	"\x13UpdateOwnershipRule\x12\x1b.prmanager.v1.OwnershipRule\x1a\x1b.prmanager.v1.OwnershipRule\x12W\n" +
+	"\x13DeleteOwnershipRule\x12(.prmanager.v1.DeleteOwnershipRuleRequest\x1a\x16.google.protobuf.Empty2\x8d\a\n" +
+	"\vUserService\x12C\n" +
+	"\vSetIsActive\x12 .prmanager.v1.SetIsActiveRequest\x1a\x12.prmanager.v1.User\x12<\n" +
+	"\aSetTags\x12\x1d.prmanager.v1.UserTagsRequest\x1a\x12.prmanager.v1.User\x12<\n" +
+	"\aAddTags\x12\x1d.prmanager.v1.UserTagsRequest\x1a\x12.prmanager.v1.User\x12?\n" +
+	"\n" +
+	"RemoveTags\x12\x1d.prmanager.v1.UserTagsRequest\x1a\x12.prmanager.v1.User\x12O\n" +
+	"\x11SetMaxOpenReviews\x12&.prmanager.v1.SetMaxOpenReviewsRequest\x1a\x12.prmanager.v1.User\x12E\n" +
+	"\vSetContacts\x12\x1a.prmanager.v1.UserContacts\x1a\x1a.prmanager.v1.UserContacts\x12G\n" +
+	"\vGetContacts\x12\x1c.prmanager.v1.GetUserRequest\x1a\x1a.prmanager.v1.UserContacts\x12O\n" +
+	"\x11AddUnavailability\x12\x1c.prmanager.v1.Unavailability\x1a\x1c.prmanager.v1.Unavailability\x12S\n" +
+	"\x11GetUnavailability\x12\x1c.prmanager.v1.GetUserRequest\x1a .prmanager.v1.UserUnavailability\x12Y\n" +
+	"\x14RemoveUnavailability\x12).prmanager.v1.RemoveUnavailabilityRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x0eBulkDeactivate\x12#.prmanager.v1.BulkDeactivateRequest\x1a\".prmanager.v1.BulkDeactivateResult\x12?\n" +
+	"\tGetReview\x12\x1c.prmanager.v1.GetUserRequest\x1a\x14.prmanager.v1.Review2\xd0\a\n" +
+	"\x12PullRequestService\x12]\n" +
+	"\x11CreatePullRequest\x12&.prmanager.v1.CreatePullRequestRequest\x1a .prmanager.v1.CreatedPullRequest\x12Z\n" +
+	"\x10MergePullRequest\x12%.prmanager.v1.MergePullRequestRequest\x1a\x1f.prmanager.v1.MergedPullRequest\x12O\n" +
+	"\tMarkReady\x12!.prmanager.v1.ChangeStatusRequest\x1a\x1f.prmanager.v1.PullRequestStatus\x12K\n" +
+	"\x05Close\x12!.prmanager.v1.ChangeStatusRequest\x1a\x1f.prmanager.v1.PullRequestStatus\x12L\n" +
+	"\x06Reopen\x12!.prmanager.v1.ChangeStatusRequest\x1a\x1f.prmanager.v1.PullRequestStatus\x12N\n" +
+	"\bReassign\x12\x1d.prmanager.v1.ReassignRequest\x1a#.prmanager.v1.ReassignedPullRequest\x12X\n" +
+	"\fAddReviewers\x12$.prmanager.v1.ChangeReviewersRequest\x1a\".prmanager.v1.PullRequestReviewers\x12[\n" +
+	"\x0fRemoveReviewers\x12$.prmanager.v1.ChangeReviewersRequest\x1a\".prmanager.v1.PullRequestReviewers\x12P\n" +
+	"\fSubmitReview\x12!.prmanager.v1.SubmitReviewRequest\x1a\x1d.prmanager.v1.SubmittedReview\x12S\n" +
+	"\n" +
+	"GetHistory\x12#.prmanager.v1.GetPullRequestRequest\x1a .prmanager.v1.PullRequestHistory\x12e\n" +
+	"\x18GetAssignmentExplanation\x12#.prmanager.v1.GetPullRequestRequest\x1a$.prmanager.v1.AssignmentExplanationsB\x13Z\x11PRmanager/api;apib\x06proto3"
+
+var (
+	file_api_prmanager_proto_rawDescOnce sync.Once
+	file_api_prmanager_proto_rawDescData []byte
+)
+
+func file_api_prmanager_proto_rawDescGZIP() []byte {
+	file_api_prmanager_proto_rawDescOnce.Do(func() {
+		file_api_prmanager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_prmanager_proto_rawDesc), len(file_api_prmanager_proto_rawDesc)))
+	})
+	return file_api_prmanager_proto_rawDescData
+}
+
+var file_api_prmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_api_prmanager_proto_goTypes = []any{
+	(*Team)(nil),                        // 0: prmanager.v1.Team
+	(*MergePolicy)(nil),                 // 1: prmanager.v1.MergePolicy
+	(*Member)(nil),                      // 2: prmanager.v1.Member
+	(*GetTeamRequest)(nil),              // 3: prmanager.v1.GetTeamRequest
+	(*SetReviewerStrategyRequest)(nil),  // 4: prmanager.v1.SetReviewerStrategyRequest
+	(*SetReviewersCountRequest)(nil),    // 5: prmanager.v1.SetReviewersCountRequest
+	(*SetRequiredApprovalsRequest)(nil), // 6: prmanager.v1.SetRequiredApprovalsRequest
+	(*SetMergePolicyRequest)(nil),       // 7: prmanager.v1.SetMergePolicyRequest
+	(*SetReassignPoolRequest)(nil),      // 8: prmanager.v1.SetReassignPoolRequest
+	(*SetPairingLookbackRequest)(nil),   // 9: prmanager.v1.SetPairingLookbackRequest
+	(*SetPartnerTeamsRequest)(nil),      // 10: prmanager.v1.SetPartnerTeamsRequest
+	(*UpdateTeamMembersRequest)(nil),    // 11: prmanager.v1.UpdateTeamMembersRequest
+	(*RemoveTeamMembersRequest)(nil),    // 12: prmanager.v1.RemoveTeamMembersRequest
+	(*MoveUserRequest)(nil),             // 13: prmanager.v1.MoveUserRequest
+	(*ReviewerChange)(nil),              // 14: prmanager.v1.ReviewerChange
+	(*TeamMembersUpdated)(nil),          // 15: prmanager.v1.TeamMembersUpdated
+	(*OwnershipRule)(nil),               // 16: prmanager.v1.OwnershipRule
+	(*OwnershipRules)(nil),              // 17: prmanager.v1.OwnershipRules
+	(*DeleteOwnershipRuleRequest)(nil),  // 18: prmanager.v1.DeleteOwnershipRuleRequest
+	(*User)(nil),                        // 19: prmanager.v1.User
+	(*GetUserRequest)(nil),              // 20: prmanager.v1.GetUserRequest
+	(*SetIsActiveRequest)(nil),          // 21: prmanager.v1.SetIsActiveRequest
+	(*UserTagsRequest)(nil),             // 22: prmanager.v1.UserTagsRequest
+	(*SetMaxOpenReviewsRequest)(nil),    // 23: prmanager.v1.SetMaxOpenReviewsRequest
+	(*UserContacts)(nil),                // 24: prmanager.v1.UserContacts
+	(*Unavailability)(nil),              // 25: prmanager.v1.Unavailability
+	(*UserUnavailability)(nil),          // 26: prmanager.v1.UserUnavailability
+	(*RemoveUnavailabilityRequest)(nil), // 27: prmanager.v1.RemoveUnavailabilityRequest
+	(*BulkDeactivateRequest)(nil),       // 28: prmanager.v1.BulkDeactivateRequest
+	(*BulkDeactivateResult)(nil),        // 29: prmanager.v1.BulkDeactivateResult
+	(*Review)(nil),                      // 30: prmanager.v1.Review
+	(*PullRequestShort)(nil),            // 31: prmanager.v1.PullRequestShort
+	(*CreatePullRequestRequest)(nil),    // 32: prmanager.v1.CreatePullRequestRequest
+	(*ReviewerPool)(nil),                // 33: prmanager.v1.ReviewerPool
+	(*CreatedPullRequest)(nil),          // 34: prmanager.v1.CreatedPullRequest
+	(*MergePullRequestRequest)(nil),     // 35: prmanager.v1.MergePullRequestRequest
+	(*ReviewerDecision)(nil),            // 36: prmanager.v1.ReviewerDecision
+	(*MergedPullRequest)(nil),           // 37: prmanager.v1.MergedPullRequest
+	(*ChangeStatusRequest)(nil),         // 38: prmanager.v1.ChangeStatusRequest
+	(*PullRequestStatus)(nil),           // 39: prmanager.v1.PullRequestStatus
+	(*ReassignRequest)(nil),             // 40: prmanager.v1.ReassignRequest
+	(*ReassignedPullRequest)(nil),       // 41: prmanager.v1.ReassignedPullRequest
+	(*ChangeReviewersRequest)(nil),      // 42: prmanager.v1.ChangeReviewersRequest
+	(*PullRequestReviewers)(nil),        // 43: prmanager.v1.PullRequestReviewers
+	(*SubmitReviewRequest)(nil),         // 44: prmanager.v1.SubmitReviewRequest
+	(*SubmittedReview)(nil),             // 45: prmanager.v1.SubmittedReview
+	(*GetPullRequestRequest)(nil),       // 46: prmanager.v1.GetPullRequestRequest
+	(*PullRequestEvent)(nil),            // 47: prmanager.v1.PullRequestEvent
+	(*PullRequestHistory)(nil),          // 48: prmanager.v1.PullRequestHistory
+	(*AssignmentCandidate)(nil),         // 49: prmanager.v1.AssignmentCandidate
+	(*ExcludedCandidate)(nil),           // 50: prmanager.v1.ExcludedCandidate
+	(*AssignmentExplanation)(nil),       // 51: prmanager.v1.AssignmentExplanation
+	(*AssignmentExplanations)(nil),      // 52: prmanager.v1.AssignmentExplanations
+	(*emptypb.Empty)(nil),               // 53: google.protobuf.Empty
+}
+var file_api_prmanager_proto_depIdxs = []int32{
+	1,  // 0: prmanager.v1.Team.merge_policy:type_name -> prmanager.v1.MergePolicy
+	2,  // 1: prmanager.v1.Team.members:type_name -> prmanager.v1.Member
+	1,  // 2: prmanager.v1.SetMergePolicyRequest.merge_policy:type_name -> prmanager.v1.MergePolicy
+	2,  // 3: prmanager.v1.UpdateTeamMembersRequest.members:type_name -> prmanager.v1.Member
+	0,  // 4: prmanager.v1.TeamMembersUpdated.team:type_name -> prmanager.v1.Team
+	14, // 5: prmanager.v1.TeamMembersUpdated.reassigned:type_name -> prmanager.v1.ReviewerChange
+	16, // 6: prmanager.v1.OwnershipRules.rules:type_name -> prmanager.v1.OwnershipRule
+	25, // 7: prmanager.v1.UserUnavailability.periods:type_name -> prmanager.v1.Unavailability
+	14, // 8: prmanager.v1.BulkDeactivateResult.reassigned:type_name -> prmanager.v1.ReviewerChange
+	14, // 9: prmanager.v1.BulkDeactivateResult.dropped:type_name -> prmanager.v1.ReviewerChange
+	31, // 10: prmanager.v1.Review.pull_requests:type_name -> prmanager.v1.PullRequestShort
+	33, // 11: prmanager.v1.CreatedPullRequest.reviewer_pools:type_name -> prmanager.v1.ReviewerPool
+	36, // 12: prmanager.v1.MergedPullRequest.reviews:type_name -> prmanager.v1.ReviewerDecision
+	33, // 13: prmanager.v1.PullRequestStatus.reviewer_pools:type_name -> prmanager.v1.ReviewerPool
+	47, // 14: prmanager.v1.PullRequestHistory.events:type_name -> prmanager.v1.PullRequestEvent
+	49, // 15: prmanager.v1.AssignmentExplanation.candidates:type_name -> prmanager.v1.AssignmentCandidate
+	50, // 16: prmanager.v1.AssignmentExplanation.excluded:type_name -> prmanager.v1.ExcludedCandidate
+	51, // 17: prmanager.v1.AssignmentExplanations.assignments:type_name -> prmanager.v1.AssignmentExplanation
+	0,  // 18: prmanager.v1.TeamService.AddTeam:input_type -> prmanager.v1.Team
+	3,  // 19: prmanager.v1.TeamService.GetTeam:input_type -> prmanager.v1.GetTeamRequest
+	4,  // 20: prmanager.v1.TeamService.SetReviewerStrategy:input_type -> prmanager.v1.SetReviewerStrategyRequest
+	5,  // 21: prmanager.v1.TeamService.SetReviewersCount:input_type -> prmanager.v1.SetReviewersCountRequest
+	6,  // 22: prmanager.v1.TeamService.SetRequiredApprovals:input_type -> prmanager.v1.SetRequiredApprovalsRequest
+	7,  // 23: prmanager.v1.TeamService.SetMergePolicy:input_type -> prmanager.v1.SetMergePolicyRequest
+	8,  // 24: prmanager.v1.TeamService.SetReassignPool:input_type -> prmanager.v1.SetReassignPoolRequest
+	9,  // 25: prmanager.v1.TeamService.SetPairingLookback:input_type -> prmanager.v1.SetPairingLookbackRequest
+	10, // 26: prmanager.v1.TeamService.SetPartnerTeams:input_type -> prmanager.v1.SetPartnerTeamsRequest
+	11, // 27: prmanager.v1.TeamService.UpdateTeamMembers:input_type -> prmanager.v1.UpdateTeamMembersRequest
+	12, // 28: prmanager.v1.TeamService.RemoveTeamMembers:input_type -> prmanager.v1.RemoveTeamMembersRequest
+	13, // 29: prmanager.v1.TeamService.MoveUser:input_type -> prmanager.v1.MoveUserRequest
+	16, // 30: prmanager.v1.TeamService.AddOwnershipRule:input_type -> prmanager.v1.OwnershipRule
+	3,  // 31: prmanager.v1.TeamService.GetOwnershipRules:input_type -> prmanager.v1.GetTeamRequest
+	16, // 32: prmanager.v1.TeamService.UpdateOwnershipRule:input_type -> prmanager.v1.OwnershipRule
+	18, // 33: prmanager.v1.TeamService.DeleteOwnershipRule:input_type -> prmanager.v1.DeleteOwnershipRuleRequest
+	21, // 34: prmanager.v1.UserService.SetIsActive:input_type -> prmanager.v1.SetIsActiveRequest
+	22, // 35: prmanager.v1.UserService.SetTags:input_type -> prmanager.v1.UserTagsRequest
+	22, // 36: prmanager.v1.UserService.AddTags:input_type -> prmanager.v1.UserTagsRequest
+	22, // 37: prmanager.v1.UserService.RemoveTags:input_type -> prmanager.v1.UserTagsRequest
+	23, // 38: prmanager.v1.UserService.SetMaxOpenReviews:input_type -> prmanager.v1.SetMaxOpenReviewsRequest
+	24, // 39: prmanager.v1.UserService.SetContacts:input_type -> prmanager.v1.UserContacts
+	20, // 40: prmanager.v1.UserService.GetContacts:input_type -> prmanager.v1.GetUserRequest
+	25, // 41: prmanager.v1.UserService.AddUnavailability:input_type -> prmanager.v1.Unavailability
+	20, // 42: prmanager.v1.UserService.GetUnavailability:input_type -> prmanager.v1.GetUserRequest
+	27, // 43: prmanager.v1.UserService.RemoveUnavailability:input_type -> prmanager.v1.RemoveUnavailabilityRequest
+	28, // 44: prmanager.v1.UserService.BulkDeactivate:input_type -> prmanager.v1.BulkDeactivateRequest
+	20, // 45: prmanager.v1.UserService.GetReview:input_type -> prmanager.v1.GetUserRequest
+	32, // 46: prmanager.v1.PullRequestService.CreatePullRequest:input_type -> prmanager.v1.CreatePullRequestRequest
+	35, // 47: prmanager.v1.PullRequestService.MergePullRequest:input_type -> prmanager.v1.MergePullRequestRequest
+	38, // 48: prmanager.v1.PullRequestService.MarkReady:input_type -> prmanager.v1.ChangeStatusRequest
+	38, // 49: prmanager.v1.PullRequestService.Close:input_type -> prmanager.v1.ChangeStatusRequest
+	38, // 50: prmanager.v1.PullRequestService.Reopen:input_type -> prmanager.v1.ChangeStatusRequest
+	40, // 51: prmanager.v1.PullRequestService.Reassign:input_type -> prmanager.v1.ReassignRequest
+	42, // 52: prmanager.v1.PullRequestService.AddReviewers:input_type -> prmanager.v1.ChangeReviewersRequest
+	42, // 53: prmanager.v1.PullRequestService.RemoveReviewers:input_type -> prmanager.v1.ChangeReviewersRequest
+	44, // 54: prmanager.v1.PullRequestService.SubmitReview:input_type -> prmanager.v1.SubmitReviewRequest
+	46, // 55: prmanager.v1.PullRequestService.GetHistory:input_type -> prmanager.v1.GetPullRequestRequest
+	46, // 56: prmanager.v1.PullRequestService.GetAssignmentExplanation:input_type -> prmanager.v1.GetPullRequestRequest
+	0,  // 57: prmanager.v1.TeamService.AddTeam:output_type -> prmanager.v1.Team
+	0,  // 58: prmanager.v1.TeamService.GetTeam:output_type -> prmanager.v1.Team
+	0,  // 59: prmanager.v1.TeamService.SetReviewerStrategy:output_type -> prmanager.v1.Team
+	0,  // 60: prmanager.v1.TeamService.SetReviewersCount:output_type -> prmanager.v1.Team
+	0,  // 61: prmanager.v1.TeamService.SetRequiredApprovals:output_type -> prmanager.v1.Team
+	0,  // 62: prmanager.v1.TeamService.SetMergePolicy:output_type -> prmanager.v1.Team
+	0,  // 63: prmanager.v1.TeamService.SetReassignPool:output_type -> prmanager.v1.Team
+	0,  // 64: prmanager.v1.TeamService.SetPairingLookback:output_type -> prmanager.v1.Team
+	0,  // 65: prmanager.v1.TeamService.SetPartnerTeams:output_type -> prmanager.v1.Team
+	15, // 66: prmanager.v1.TeamService.UpdateTeamMembers:output_type -> prmanager.v1.TeamMembersUpdated
+	15, // 67: prmanager.v1.TeamService.RemoveTeamMembers:output_type -> prmanager.v1.TeamMembersUpdated
+	15, // 68: prmanager.v1.TeamService.MoveUser:output_type -> prmanager.v1.TeamMembersUpdated
+	16, // 69: prmanager.v1.TeamService.AddOwnershipRule:output_type -> prmanager.v1.OwnershipRule
+	17, // 70: prmanager.v1.TeamService.GetOwnershipRules:output_type -> prmanager.v1.OwnershipRules
+	16, // 71: prmanager.v1.TeamService.UpdateOwnershipRule:output_type -> prmanager.v1.OwnershipRule
+	53, // 72: prmanager.v1.TeamService.DeleteOwnershipRule:output_type -> google.protobuf.Empty
+	19, // 73: prmanager.v1.UserService.SetIsActive:output_type -> prmanager.v1.User
+	19, // 74: prmanager.v1.UserService.SetTags:output_type -> prmanager.v1.User
+	19, // 75: prmanager.v1.UserService.AddTags:output_type -> prmanager.v1.User
+	19, // 76: prmanager.v1.UserService.RemoveTags:output_type -> prmanager.v1.User
+	19, // 77: prmanager.v1.UserService.SetMaxOpenReviews:output_type -> prmanager.v1.User
+	24, // 78: prmanager.v1.UserService.SetContacts:output_type -> prmanager.v1.UserContacts
+	24, // 79: prmanager.v1.UserService.GetContacts:output_type -> prmanager.v1.UserContacts
+	25, // 80: prmanager.v1.UserService.AddUnavailability:output_type -> prmanager.v1.Unavailability
+	26, // 81: prmanager.v1.UserService.GetUnavailability:output_type -> prmanager.v1.UserUnavailability
+	53, // 82: prmanager.v1.UserService.RemoveUnavailability:output_type -> google.protobuf.Empty
+	29, // 83: prmanager.v1.UserService.BulkDeactivate:output_type -> prmanager.v1.BulkDeactivateResult
+	30, // 84: prmanager.v1.UserService.GetReview:output_type -> prmanager.v1.Review
+	34, // 85: prmanager.v1.PullRequestService.CreatePullRequest:output_type -> prmanager.v1.CreatedPullRequest
+	37, // 86: prmanager.v1.PullRequestService.MergePullRequest:output_type -> prmanager.v1.MergedPullRequest
+	39, // 87: prmanager.v1.PullRequestService.MarkReady:output_type -> prmanager.v1.PullRequestStatus
+	39, // 88: prmanager.v1.PullRequestService.Close:output_type -> prmanager.v1.PullRequestStatus
+	39, // 89: prmanager.v1.PullRequestService.Reopen:output_type -> prmanager.v1.PullRequestStatus
+	41, // 90: prmanager.v1.PullRequestService.Reassign:output_type -> prmanager.v1.ReassignedPullRequest
+	43, // 91: prmanager.v1.PullRequestService.AddReviewers:output_type -> prmanager.v1.PullRequestReviewers
+	43, // 92: prmanager.v1.PullRequestService.RemoveReviewers:output_type -> prmanager.v1.PullRequestReviewers
+	45, // 93: prmanager.v1.PullRequestService.SubmitReview:output_type -> prmanager.v1.SubmittedReview
+	48, // 94: prmanager.v1.PullRequestService.GetHistory:output_type -> prmanager.v1.PullRequestHistory
+	52, // 95: prmanager.v1.PullRequestService.GetAssignmentExplanation:output_type -> prmanager.v1.AssignmentExplanations
+	57, // [57:96] is the sub-list for method output_type
+	18, // [18:57] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_api_prmanager_proto_init() }
+func file_api_prmanager_proto_init() {
+	if File_api_prmanager_proto != nil {
+		return
+	}
+	file_api_prmanager_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[2].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[19].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[23].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_prmanager_proto_msgTypes[51].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_prmanager_proto_rawDesc), len(file_api_prmanager_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   53,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_api_prmanager_proto_goTypes,
+		DependencyIndexes: file_api_prmanager_proto_depIdxs,
+		MessageInfos:      file_api_prmanager_proto_msgTypes,
+	}.Build()
+	File_api_prmanager_proto = out.File
+	file_api_prmanager_proto_goTypes = nil
+	file_api_prmanager_proto_depIdxs = nil
+}
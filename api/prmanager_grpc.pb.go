@@ -0,0 +1,1709 @@
+// gRPC API of the PR reviewer manager. It mirrors the HTTP API: every rpc
+// calls the same usecase method as its HTTP route and fields are named after
+// the JSON ones. Times are RFC 3339 strings, as in JSON.
+//
+// Errors carry the HTTP error code (e.g. "PR_EXISTS") as the reason of a
+// google.rpc.ErrorInfo detail; a violated merge policy also lists its rules
+// in a google.rpc.PreconditionFailure detail.
+//
+// The caller is taken from the "x-actor-id" metadata, like the X-Actor-Id
+// HTTP header.
+//
+// Regenerate with:
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//     --go-grpc_out=. --go-grpc_opt=paths=source_relative api/prmanager.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/prmanager.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TeamService_AddTeam_FullMethodName              = "/prmanager.v1.TeamService/AddTeam"
+	TeamService_GetTeam_FullMethodName              = "/prmanager.v1.TeamService/GetTeam"
+	TeamService_SetReviewerStrategy_FullMethodName  = "/prmanager.v1.TeamService/SetReviewerStrategy"
+	TeamService_SetReviewersCount_FullMethodName    = "/prmanager.v1.TeamService/SetReviewersCount"
+	TeamService_SetRequiredApprovals_FullMethodName = "/prmanager.v1.TeamService/SetRequiredApprovals"
+	TeamService_SetMergePolicy_FullMethodName       = "/prmanager.v1.TeamService/SetMergePolicy"
+	TeamService_SetReassignPool_FullMethodName      = "/prmanager.v1.TeamService/SetReassignPool"
+	TeamService_SetPairingLookback_FullMethodName   = "/prmanager.v1.TeamService/SetPairingLookback"
+	TeamService_SetPartnerTeams_FullMethodName      = "/prmanager.v1.TeamService/SetPartnerTeams"
+	TeamService_UpdateTeamMembers_FullMethodName    = "/prmanager.v1.TeamService/UpdateTeamMembers"
+	TeamService_RemoveTeamMembers_FullMethodName    = "/prmanager.v1.TeamService/RemoveTeamMembers"
+	TeamService_MoveUser_FullMethodName             = "/prmanager.v1.TeamService/MoveUser"
+	TeamService_AddOwnershipRule_FullMethodName     = "/prmanager.v1.TeamService/AddOwnershipRule"
+	TeamService_GetOwnershipRules_FullMethodName    = "/prmanager.v1.TeamService/GetOwnershipRules"
+	TeamService_UpdateOwnershipRule_FullMethodName  = "/prmanager.v1.TeamService/UpdateOwnershipRule"
+	TeamService_DeleteOwnershipRule_FullMethodName  = "/prmanager.v1.TeamService/DeleteOwnershipRule"
+)
+
+// TeamServiceClient is the client API for TeamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TeamServiceClient interface {
+	AddTeam(ctx context.Context, in *Team, opts ...grpc.CallOption) (*Team, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	SetReviewerStrategy(ctx context.Context, in *SetReviewerStrategyRequest, opts ...grpc.CallOption) (*Team, error)
+	SetReviewersCount(ctx context.Context, in *SetReviewersCountRequest, opts ...grpc.CallOption) (*Team, error)
+	SetRequiredApprovals(ctx context.Context, in *SetRequiredApprovalsRequest, opts ...grpc.CallOption) (*Team, error)
+	SetMergePolicy(ctx context.Context, in *SetMergePolicyRequest, opts ...grpc.CallOption) (*Team, error)
+	SetReassignPool(ctx context.Context, in *SetReassignPoolRequest, opts ...grpc.CallOption) (*Team, error)
+	SetPairingLookback(ctx context.Context, in *SetPairingLookbackRequest, opts ...grpc.CallOption) (*Team, error)
+	SetPartnerTeams(ctx context.Context, in *SetPartnerTeamsRequest, opts ...grpc.CallOption) (*Team, error)
+	UpdateTeamMembers(ctx context.Context, in *UpdateTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error)
+	RemoveTeamMembers(ctx context.Context, in *RemoveTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error)
+	MoveUser(ctx context.Context, in *MoveUserRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error)
+	AddOwnershipRule(ctx context.Context, in *OwnershipRule, opts ...grpc.CallOption) (*OwnershipRule, error)
+	GetOwnershipRules(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*OwnershipRules, error)
+	UpdateOwnershipRule(ctx context.Context, in *OwnershipRule, opts ...grpc.CallOption) (*OwnershipRule, error)
+	DeleteOwnershipRule(ctx context.Context, in *DeleteOwnershipRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type teamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeamServiceClient(cc grpc.ClientConnInterface) TeamServiceClient {
+	return &teamServiceClient{cc}
+}
+
+func (c *teamServiceClient) AddTeam(ctx context.Context, in *Team, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetReviewerStrategy(ctx context.Context, in *SetReviewerStrategyRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetReviewerStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetReviewersCount(ctx context.Context, in *SetReviewersCountRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetReviewersCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetRequiredApprovals(ctx context.Context, in *SetRequiredApprovalsRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetRequiredApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetMergePolicy(ctx context.Context, in *SetMergePolicyRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetMergePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetReassignPool(ctx context.Context, in *SetReassignPoolRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetReassignPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetPairingLookback(ctx context.Context, in *SetPairingLookbackRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetPairingLookback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetPartnerTeams(ctx context.Context, in *SetPartnerTeamsRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetPartnerTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) UpdateTeamMembers(ctx context.Context, in *UpdateTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembersUpdated)
+	err := c.cc.Invoke(ctx, TeamService_UpdateTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) RemoveTeamMembers(ctx context.Context, in *RemoveTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembersUpdated)
+	err := c.cc.Invoke(ctx, TeamService_RemoveTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) MoveUser(ctx context.Context, in *MoveUserRequest, opts ...grpc.CallOption) (*TeamMembersUpdated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembersUpdated)
+	err := c.cc.Invoke(ctx, TeamService_MoveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) AddOwnershipRule(ctx context.Context, in *OwnershipRule, opts ...grpc.CallOption) (*OwnershipRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnershipRule)
+	err := c.cc.Invoke(ctx, TeamService_AddOwnershipRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetOwnershipRules(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*OwnershipRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnershipRules)
+	err := c.cc.Invoke(ctx, TeamService_GetOwnershipRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) UpdateOwnershipRule(ctx context.Context, in *OwnershipRule, opts ...grpc.CallOption) (*OwnershipRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnershipRule)
+	err := c.cc.Invoke(ctx, TeamService_UpdateOwnershipRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) DeleteOwnershipRule(ctx context.Context, in *DeleteOwnershipRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TeamService_DeleteOwnershipRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeamServiceServer is the server API for TeamService service.
+// All implementations must embed UnimplementedTeamServiceServer
+// for forward compatibility.
+type TeamServiceServer interface {
+	AddTeam(context.Context, *Team) (*Team, error)
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	SetReviewerStrategy(context.Context, *SetReviewerStrategyRequest) (*Team, error)
+	SetReviewersCount(context.Context, *SetReviewersCountRequest) (*Team, error)
+	SetRequiredApprovals(context.Context, *SetRequiredApprovalsRequest) (*Team, error)
+	SetMergePolicy(context.Context, *SetMergePolicyRequest) (*Team, error)
+	SetReassignPool(context.Context, *SetReassignPoolRequest) (*Team, error)
+	SetPairingLookback(context.Context, *SetPairingLookbackRequest) (*Team, error)
+	SetPartnerTeams(context.Context, *SetPartnerTeamsRequest) (*Team, error)
+	UpdateTeamMembers(context.Context, *UpdateTeamMembersRequest) (*TeamMembersUpdated, error)
+	RemoveTeamMembers(context.Context, *RemoveTeamMembersRequest) (*TeamMembersUpdated, error)
+	MoveUser(context.Context, *MoveUserRequest) (*TeamMembersUpdated, error)
+	AddOwnershipRule(context.Context, *OwnershipRule) (*OwnershipRule, error)
+	GetOwnershipRules(context.Context, *GetTeamRequest) (*OwnershipRules, error)
+	UpdateOwnershipRule(context.Context, *OwnershipRule) (*OwnershipRule, error)
+	DeleteOwnershipRule(context.Context, *DeleteOwnershipRuleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+// UnimplementedTeamServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeamServiceServer struct{}
+
+func (UnimplementedTeamServiceServer) AddTeam(context.Context, *Team) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) SetReviewerStrategy(context.Context, *SetReviewerStrategyRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReviewerStrategy not implemented")
+}
+func (UnimplementedTeamServiceServer) SetReviewersCount(context.Context, *SetReviewersCountRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReviewersCount not implemented")
+}
+func (UnimplementedTeamServiceServer) SetRequiredApprovals(context.Context, *SetRequiredApprovalsRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequiredApprovals not implemented")
+}
+func (UnimplementedTeamServiceServer) SetMergePolicy(context.Context, *SetMergePolicyRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMergePolicy not implemented")
+}
+func (UnimplementedTeamServiceServer) SetReassignPool(context.Context, *SetReassignPoolRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReassignPool not implemented")
+}
+func (UnimplementedTeamServiceServer) SetPairingLookback(context.Context, *SetPairingLookbackRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairingLookback not implemented")
+}
+func (UnimplementedTeamServiceServer) SetPartnerTeams(context.Context, *SetPartnerTeamsRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPartnerTeams not implemented")
+}
+func (UnimplementedTeamServiceServer) UpdateTeamMembers(context.Context, *UpdateTeamMembersRequest) (*TeamMembersUpdated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMembers not implemented")
+}
+func (UnimplementedTeamServiceServer) RemoveTeamMembers(context.Context, *RemoveTeamMembersRequest) (*TeamMembersUpdated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMembers not implemented")
+}
+func (UnimplementedTeamServiceServer) MoveUser(context.Context, *MoveUserRequest) (*TeamMembersUpdated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveUser not implemented")
+}
+func (UnimplementedTeamServiceServer) AddOwnershipRule(context.Context, *OwnershipRule) (*OwnershipRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOwnershipRule not implemented")
+}
+func (UnimplementedTeamServiceServer) GetOwnershipRules(context.Context, *GetTeamRequest) (*OwnershipRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnershipRules not implemented")
+}
+func (UnimplementedTeamServiceServer) UpdateOwnershipRule(context.Context, *OwnershipRule) (*OwnershipRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwnershipRule not implemented")
+}
+func (UnimplementedTeamServiceServer) DeleteOwnershipRule(context.Context, *DeleteOwnershipRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOwnershipRule not implemented")
+}
+func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
+func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTeamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeamServiceServer will
+// result in compilation errors.
+type UnsafeTeamServiceServer interface {
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+func RegisterTeamServiceServer(s grpc.ServiceRegistrar, srv TeamServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeamServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeamService_ServiceDesc, srv)
+}
+
+func _TeamService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Team)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddTeam(ctx, req.(*Team))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetReviewerStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReviewerStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetReviewerStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetReviewerStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetReviewerStrategy(ctx, req.(*SetReviewerStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetReviewersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReviewersCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetReviewersCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetReviewersCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetReviewersCount(ctx, req.(*SetReviewersCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetRequiredApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequiredApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetRequiredApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetRequiredApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetRequiredApprovals(ctx, req.(*SetRequiredApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetMergePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMergePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetMergePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetMergePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetMergePolicy(ctx, req.(*SetMergePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetReassignPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReassignPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetReassignPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetReassignPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetReassignPool(ctx, req.(*SetReassignPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetPairingLookback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPairingLookbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetPairingLookback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetPairingLookback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetPairingLookback(ctx, req.(*SetPairingLookbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetPartnerTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPartnerTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetPartnerTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetPartnerTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetPartnerTeams(ctx, req.(*SetPartnerTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_UpdateTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).UpdateTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_UpdateTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).UpdateTeamMembers(ctx, req.(*UpdateTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_RemoveTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).RemoveTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_RemoveTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).RemoveTeamMembers(ctx, req.(*RemoveTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_MoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).MoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_MoveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).MoveUser(ctx, req.(*MoveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_AddOwnershipRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddOwnershipRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddOwnershipRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddOwnershipRule(ctx, req.(*OwnershipRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetOwnershipRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetOwnershipRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetOwnershipRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetOwnershipRules(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_UpdateOwnershipRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).UpdateOwnershipRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_UpdateOwnershipRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).UpdateOwnershipRule(ctx, req.(*OwnershipRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_DeleteOwnershipRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOwnershipRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).DeleteOwnershipRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_DeleteOwnershipRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).DeleteOwnershipRule(ctx, req.(*DeleteOwnershipRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prmanager.v1.TeamService",
+	HandlerType: (*TeamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _TeamService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _TeamService_GetTeam_Handler,
+		},
+		{
+			MethodName: "SetReviewerStrategy",
+			Handler:    _TeamService_SetReviewerStrategy_Handler,
+		},
+		{
+			MethodName: "SetReviewersCount",
+			Handler:    _TeamService_SetReviewersCount_Handler,
+		},
+		{
+			MethodName: "SetRequiredApprovals",
+			Handler:    _TeamService_SetRequiredApprovals_Handler,
+		},
+		{
+			MethodName: "SetMergePolicy",
+			Handler:    _TeamService_SetMergePolicy_Handler,
+		},
+		{
+			MethodName: "SetReassignPool",
+			Handler:    _TeamService_SetReassignPool_Handler,
+		},
+		{
+			MethodName: "SetPairingLookback",
+			Handler:    _TeamService_SetPairingLookback_Handler,
+		},
+		{
+			MethodName: "SetPartnerTeams",
+			Handler:    _TeamService_SetPartnerTeams_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMembers",
+			Handler:    _TeamService_UpdateTeamMembers_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMembers",
+			Handler:    _TeamService_RemoveTeamMembers_Handler,
+		},
+		{
+			MethodName: "MoveUser",
+			Handler:    _TeamService_MoveUser_Handler,
+		},
+		{
+			MethodName: "AddOwnershipRule",
+			Handler:    _TeamService_AddOwnershipRule_Handler,
+		},
+		{
+			MethodName: "GetOwnershipRules",
+			Handler:    _TeamService_GetOwnershipRules_Handler,
+		},
+		{
+			MethodName: "UpdateOwnershipRule",
+			Handler:    _TeamService_UpdateOwnershipRule_Handler,
+		},
+		{
+			MethodName: "DeleteOwnershipRule",
+			Handler:    _TeamService_DeleteOwnershipRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/prmanager.proto",
+}
+
+const (
+	UserService_SetIsActive_FullMethodName          = "/prmanager.v1.UserService/SetIsActive"
+	UserService_SetTags_FullMethodName              = "/prmanager.v1.UserService/SetTags"
+	UserService_AddTags_FullMethodName              = "/prmanager.v1.UserService/AddTags"
+	UserService_RemoveTags_FullMethodName           = "/prmanager.v1.UserService/RemoveTags"
+	UserService_SetMaxOpenReviews_FullMethodName    = "/prmanager.v1.UserService/SetMaxOpenReviews"
+	UserService_SetContacts_FullMethodName          = "/prmanager.v1.UserService/SetContacts"
+	UserService_GetContacts_FullMethodName          = "/prmanager.v1.UserService/GetContacts"
+	UserService_AddUnavailability_FullMethodName    = "/prmanager.v1.UserService/AddUnavailability"
+	UserService_GetUnavailability_FullMethodName    = "/prmanager.v1.UserService/GetUnavailability"
+	UserService_RemoveUnavailability_FullMethodName = "/prmanager.v1.UserService/RemoveUnavailability"
+	UserService_BulkDeactivate_FullMethodName       = "/prmanager.v1.UserService/BulkDeactivate"
+	UserService_GetReview_FullMethodName            = "/prmanager.v1.UserService/GetReview"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*User, error)
+	SetTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error)
+	AddTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error)
+	RemoveTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error)
+	SetMaxOpenReviews(ctx context.Context, in *SetMaxOpenReviewsRequest, opts ...grpc.CallOption) (*User, error)
+	SetContacts(ctx context.Context, in *UserContacts, opts ...grpc.CallOption) (*UserContacts, error)
+	GetContacts(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserContacts, error)
+	AddUnavailability(ctx context.Context, in *Unavailability, opts ...grpc.CallOption) (*Unavailability, error)
+	GetUnavailability(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserUnavailability, error)
+	RemoveUnavailability(ctx context.Context, in *RemoveUnavailabilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkDeactivate(ctx context.Context, in *BulkDeactivateRequest, opts ...grpc.CallOption) (*BulkDeactivateResult, error)
+	GetReview(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*Review, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetIsActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_AddTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveTags(ctx context.Context, in *UserTagsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_RemoveTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetMaxOpenReviews(ctx context.Context, in *SetMaxOpenReviewsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetMaxOpenReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetContacts(ctx context.Context, in *UserContacts, opts ...grpc.CallOption) (*UserContacts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserContacts)
+	err := c.cc.Invoke(ctx, UserService_SetContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetContacts(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserContacts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserContacts)
+	err := c.cc.Invoke(ctx, UserService_GetContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddUnavailability(ctx context.Context, in *Unavailability, opts ...grpc.CallOption) (*Unavailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Unavailability)
+	err := c.cc.Invoke(ctx, UserService_AddUnavailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUnavailability(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserUnavailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserUnavailability)
+	err := c.cc.Invoke(ctx, UserService_GetUnavailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveUnavailability(ctx context.Context, in *RemoveUnavailabilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RemoveUnavailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BulkDeactivate(ctx context.Context, in *BulkDeactivateRequest, opts ...grpc.CallOption) (*BulkDeactivateResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeactivateResult)
+	err := c.cc.Invoke(ctx, UserService_BulkDeactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReview(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, UserService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+type UserServiceServer interface {
+	SetIsActive(context.Context, *SetIsActiveRequest) (*User, error)
+	SetTags(context.Context, *UserTagsRequest) (*User, error)
+	AddTags(context.Context, *UserTagsRequest) (*User, error)
+	RemoveTags(context.Context, *UserTagsRequest) (*User, error)
+	SetMaxOpenReviews(context.Context, *SetMaxOpenReviewsRequest) (*User, error)
+	SetContacts(context.Context, *UserContacts) (*UserContacts, error)
+	GetContacts(context.Context, *GetUserRequest) (*UserContacts, error)
+	AddUnavailability(context.Context, *Unavailability) (*Unavailability, error)
+	GetUnavailability(context.Context, *GetUserRequest) (*UserUnavailability, error)
+	RemoveUnavailability(context.Context, *RemoveUnavailabilityRequest) (*emptypb.Empty, error)
+	BulkDeactivate(context.Context, *BulkDeactivateRequest) (*BulkDeactivateResult, error)
+	GetReview(context.Context, *GetUserRequest) (*Review, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) SetIsActive(context.Context, *SetIsActiveRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsActive not implemented")
+}
+func (UnimplementedUserServiceServer) SetTags(context.Context, *UserTagsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTags not implemented")
+}
+func (UnimplementedUserServiceServer) AddTags(context.Context, *UserTagsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveTags(context.Context, *UserTagsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedUserServiceServer) SetMaxOpenReviews(context.Context, *SetMaxOpenReviewsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxOpenReviews not implemented")
+}
+func (UnimplementedUserServiceServer) SetContacts(context.Context, *UserContacts) (*UserContacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContacts not implemented")
+}
+func (UnimplementedUserServiceServer) GetContacts(context.Context, *GetUserRequest) (*UserContacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContacts not implemented")
+}
+func (UnimplementedUserServiceServer) AddUnavailability(context.Context, *Unavailability) (*Unavailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUnavailability not implemented")
+}
+func (UnimplementedUserServiceServer) GetUnavailability(context.Context, *GetUserRequest) (*UserUnavailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnavailability not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveUnavailability(context.Context, *RemoveUnavailabilityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUnavailability not implemented")
+}
+func (UnimplementedUserServiceServer) BulkDeactivate(context.Context, *BulkDeactivateRequest) (*BulkDeactivateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeactivate not implemented")
+}
+func (UnimplementedUserServiceServer) GetReview(context.Context, *GetUserRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_SetIsActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIsActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetIsActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetIsActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetIsActive(ctx, req.(*SetIsActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetTags(ctx, req.(*UserTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddTags(ctx, req.(*UserTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveTags(ctx, req.(*UserTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetMaxOpenReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxOpenReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetMaxOpenReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetMaxOpenReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetMaxOpenReviews(ctx, req.(*SetMaxOpenReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserContacts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetContacts(ctx, req.(*UserContacts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetContacts(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddUnavailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Unavailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddUnavailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddUnavailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddUnavailability(ctx, req.(*Unavailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUnavailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUnavailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUnavailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUnavailability(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveUnavailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUnavailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveUnavailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveUnavailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveUnavailability(ctx, req.(*RemoveUnavailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BulkDeactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeactivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BulkDeactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BulkDeactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BulkDeactivate(ctx, req.(*BulkDeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReview(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prmanager.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetIsActive",
+			Handler:    _UserService_SetIsActive_Handler,
+		},
+		{
+			MethodName: "SetTags",
+			Handler:    _UserService_SetTags_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _UserService_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _UserService_RemoveTags_Handler,
+		},
+		{
+			MethodName: "SetMaxOpenReviews",
+			Handler:    _UserService_SetMaxOpenReviews_Handler,
+		},
+		{
+			MethodName: "SetContacts",
+			Handler:    _UserService_SetContacts_Handler,
+		},
+		{
+			MethodName: "GetContacts",
+			Handler:    _UserService_GetContacts_Handler,
+		},
+		{
+			MethodName: "AddUnavailability",
+			Handler:    _UserService_AddUnavailability_Handler,
+		},
+		{
+			MethodName: "GetUnavailability",
+			Handler:    _UserService_GetUnavailability_Handler,
+		},
+		{
+			MethodName: "RemoveUnavailability",
+			Handler:    _UserService_RemoveUnavailability_Handler,
+		},
+		{
+			MethodName: "BulkDeactivate",
+			Handler:    _UserService_BulkDeactivate_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _UserService_GetReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/prmanager.proto",
+}
+
+const (
+	PullRequestService_CreatePullRequest_FullMethodName        = "/prmanager.v1.PullRequestService/CreatePullRequest"
+	PullRequestService_MergePullRequest_FullMethodName         = "/prmanager.v1.PullRequestService/MergePullRequest"
+	PullRequestService_MarkReady_FullMethodName                = "/prmanager.v1.PullRequestService/MarkReady"
+	PullRequestService_Close_FullMethodName                    = "/prmanager.v1.PullRequestService/Close"
+	PullRequestService_Reopen_FullMethodName                   = "/prmanager.v1.PullRequestService/Reopen"
+	PullRequestService_Reassign_FullMethodName                 = "/prmanager.v1.PullRequestService/Reassign"
+	PullRequestService_AddReviewers_FullMethodName             = "/prmanager.v1.PullRequestService/AddReviewers"
+	PullRequestService_RemoveReviewers_FullMethodName          = "/prmanager.v1.PullRequestService/RemoveReviewers"
+	PullRequestService_SubmitReview_FullMethodName             = "/prmanager.v1.PullRequestService/SubmitReview"
+	PullRequestService_GetHistory_FullMethodName               = "/prmanager.v1.PullRequestService/GetHistory"
+	PullRequestService_GetAssignmentExplanation_FullMethodName = "/prmanager.v1.PullRequestService/GetAssignmentExplanation"
+)
+
+// PullRequestServiceClient is the client API for PullRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PullRequestServiceClient interface {
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatedPullRequest, error)
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergedPullRequest, error)
+	MarkReady(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error)
+	Close(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error)
+	Reopen(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error)
+	Reassign(ctx context.Context, in *ReassignRequest, opts ...grpc.CallOption) (*ReassignedPullRequest, error)
+	AddReviewers(ctx context.Context, in *ChangeReviewersRequest, opts ...grpc.CallOption) (*PullRequestReviewers, error)
+	RemoveReviewers(ctx context.Context, in *ChangeReviewersRequest, opts ...grpc.CallOption) (*PullRequestReviewers, error)
+	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmittedReview, error)
+	GetHistory(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*PullRequestHistory, error)
+	GetAssignmentExplanation(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*AssignmentExplanations, error)
+}
+
+type pullRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPullRequestServiceClient(cc grpc.ClientConnInterface) PullRequestServiceClient {
+	return &pullRequestServiceClient{cc}
+}
+
+func (c *pullRequestServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatedPullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedPullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergedPullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergedPullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) MarkReady(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestStatus)
+	err := c.cc.Invoke(ctx, PullRequestService_MarkReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) Close(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestStatus)
+	err := c.cc.Invoke(ctx, PullRequestService_Close_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) Reopen(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*PullRequestStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestStatus)
+	err := c.cc.Invoke(ctx, PullRequestService_Reopen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) Reassign(ctx context.Context, in *ReassignRequest, opts ...grpc.CallOption) (*ReassignedPullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignedPullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_Reassign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) AddReviewers(ctx context.Context, in *ChangeReviewersRequest, opts ...grpc.CallOption) (*PullRequestReviewers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestReviewers)
+	err := c.cc.Invoke(ctx, PullRequestService_AddReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) RemoveReviewers(ctx context.Context, in *ChangeReviewersRequest, opts ...grpc.CallOption) (*PullRequestReviewers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestReviewers)
+	err := c.cc.Invoke(ctx, PullRequestService_RemoveReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmittedReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmittedReview)
+	err := c.cc.Invoke(ctx, PullRequestService_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) GetHistory(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*PullRequestHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestHistory)
+	err := c.cc.Invoke(ctx, PullRequestService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) GetAssignmentExplanation(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*AssignmentExplanations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentExplanations)
+	err := c.cc.Invoke(ctx, PullRequestService_GetAssignmentExplanation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PullRequestServiceServer is the server API for PullRequestService service.
+// All implementations must embed UnimplementedPullRequestServiceServer
+// for forward compatibility.
+type PullRequestServiceServer interface {
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatedPullRequest, error)
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*MergedPullRequest, error)
+	MarkReady(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error)
+	Close(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error)
+	Reopen(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error)
+	Reassign(context.Context, *ReassignRequest) (*ReassignedPullRequest, error)
+	AddReviewers(context.Context, *ChangeReviewersRequest) (*PullRequestReviewers, error)
+	RemoveReviewers(context.Context, *ChangeReviewersRequest) (*PullRequestReviewers, error)
+	SubmitReview(context.Context, *SubmitReviewRequest) (*SubmittedReview, error)
+	GetHistory(context.Context, *GetPullRequestRequest) (*PullRequestHistory, error)
+	GetAssignmentExplanation(context.Context, *GetPullRequestRequest) (*AssignmentExplanations, error)
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+// UnimplementedPullRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPullRequestServiceServer struct{}
+
+func (UnimplementedPullRequestServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatedPullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPullRequestServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*MergedPullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPullRequestServiceServer) MarkReady(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReady not implemented")
+}
+func (UnimplementedPullRequestServiceServer) Close(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedPullRequestServiceServer) Reopen(context.Context, *ChangeStatusRequest) (*PullRequestStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reopen not implemented")
+}
+func (UnimplementedPullRequestServiceServer) Reassign(context.Context, *ReassignRequest) (*ReassignedPullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reassign not implemented")
+}
+func (UnimplementedPullRequestServiceServer) AddReviewers(context.Context, *ChangeReviewersRequest) (*PullRequestReviewers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReviewers not implemented")
+}
+func (UnimplementedPullRequestServiceServer) RemoveReviewers(context.Context, *ChangeReviewersRequest) (*PullRequestReviewers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReviewers not implemented")
+}
+func (UnimplementedPullRequestServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*SubmittedReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedPullRequestServiceServer) GetHistory(context.Context, *GetPullRequestRequest) (*PullRequestHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedPullRequestServiceServer) GetAssignmentExplanation(context.Context, *GetPullRequestRequest) (*AssignmentExplanations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentExplanation not implemented")
+}
+func (UnimplementedPullRequestServiceServer) mustEmbedUnimplementedPullRequestServiceServer() {}
+func (UnimplementedPullRequestServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePullRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PullRequestServiceServer will
+// result in compilation errors.
+type UnsafePullRequestServiceServer interface {
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+func RegisterPullRequestServiceServer(s grpc.ServiceRegistrar, srv PullRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPullRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PullRequestService_ServiceDesc, srv)
+}
+
+func _PullRequestService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_MarkReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).MarkReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_MarkReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).MarkReady(ctx, req.(*ChangeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_Close_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).Close(ctx, req.(*ChangeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_Reopen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).Reopen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_Reopen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).Reopen(ctx, req.(*ChangeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_Reassign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).Reassign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_Reassign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).Reassign(ctx, req.(*ReassignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_AddReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).AddReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_AddReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).AddReviewers(ctx, req.(*ChangeReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_RemoveReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).RemoveReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_RemoveReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).RemoveReviewers(ctx, req.(*ChangeReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).GetHistory(ctx, req.(*GetPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_GetAssignmentExplanation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).GetAssignmentExplanation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_GetAssignmentExplanation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).GetAssignmentExplanation(ctx, req.(*GetPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PullRequestService_ServiceDesc is the grpc.ServiceDesc for PullRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PullRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prmanager.v1.PullRequestService",
+	HandlerType: (*PullRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PullRequestService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PullRequestService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "MarkReady",
+			Handler:    _PullRequestService_MarkReady_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _PullRequestService_Close_Handler,
+		},
+		{
+			MethodName: "Reopen",
+			Handler:    _PullRequestService_Reopen_Handler,
+		},
+		{
+			MethodName: "Reassign",
+			Handler:    _PullRequestService_Reassign_Handler,
+		},
+		{
+			MethodName: "AddReviewers",
+			Handler:    _PullRequestService_AddReviewers_Handler,
+		},
+		{
+			MethodName: "RemoveReviewers",
+			Handler:    _PullRequestService_RemoveReviewers_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _PullRequestService_SubmitReview_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _PullRequestService_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetAssignmentExplanation",
+			Handler:    _PullRequestService_GetAssignmentExplanation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/prmanager.proto",
+}
@@ -3,36 +3,123 @@ package main
 import (
 	"PRmanager/config"
 	"PRmanager/internal/delivery"
+	"PRmanager/internal/delivery/rpc"
 	"PRmanager/internal/repository"
 	"PRmanager/internal/usecase"
+	"PRmanager/internal/usecase/notify"
+	"PRmanager/pkg/actor"
 	"PRmanager/pkg/logs"
 	"PRmanager/pkg/panic"
+	"context"
 	"log"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 func main() {
 	cfg := config.LoadConfig()
 	repo := repository.NewDatabase(cfg)
-	uc := usecase.NewUseCase(repo)
+	uc := usecase.NewUseCase(repo, usecase.WithNotifier(newNotifier(cfg)))
 	handler := delivery.NewHandler(uc, cfg)
 
+	go uc.RunUnavailabilityJob(context.Background(), cfg.UnavailabilityCheckInterval)
+	go uc.RunWebhookDispatcher(context.Background(), cfg.WebhookDispatchInterval)
+
 	r := chi.NewRouter()
 	r.Use(panic.PanicMiddleware)
 	r.Use(logs.LoggerMiddleware)
+	r.Use(actor.ActorMiddleware)
+	r.Use(actor.AdminMiddleware(cfg.Admins))
 
 	r.Post("/team/add", handler.AddTeam)
 	r.Get("/team/get", handler.GetTeam)
+	r.Post("/team/setReviewerStrategy", handler.SetReviewerStrategy)
+	r.Post("/team/setReviewersCount", handler.SetReviewersCount)
+	r.Post("/team/setRequiredApprovals", handler.SetRequiredApprovals)
+	r.Post("/team/setMergePolicy", handler.SetMergePolicy)
+	r.Post("/team/setReassignPool", handler.SetReassignPool)
+	r.Post("/team/setPairingLookback", handler.SetPairingLookback)
+	r.Post("/team/setPartners", handler.SetPartnerTeams)
+	r.Post("/team/updateMembers", handler.UpdateTeamMembers)
+	r.Post("/team/removeMembers", handler.RemoveTeamMembers)
+	r.Post("/team/moveUser", handler.MoveUser)
+
+	r.Post("/ownership/add", handler.AddOwnershipRule)
+	r.Get("/ownership/get", handler.GetOwnershipRules)
+	r.Post("/ownership/update", handler.UpdateOwnershipRule)
+	r.Post("/ownership/delete", handler.DeleteOwnershipRule)
 
 	r.Post("/users/setIsActive", handler.SetIsActive)
+	r.Post("/users/bulkDeactivate", handler.BulkDeactivate)
+	r.Post("/users/setTags", handler.SetUserTags)
+	r.Post("/users/addTags", handler.AddUserTags)
+	r.Post("/users/removeTags", handler.RemoveUserTags)
+	r.Post("/users/setMaxOpenReviews", handler.SetMaxOpenReviews)
+	r.Post("/users/setContacts", handler.SetContacts)
+	r.Get("/users/contacts", handler.GetContacts)
+	r.Post("/users/addUnavailability", handler.AddUnavailability)
+	r.Get("/users/unavailability", handler.GetUnavailability)
+	r.Post("/users/removeUnavailability", handler.RemoveUnavailability)
 	r.Get("/users/getReview", handler.GetReview)
 
 	r.Post("/pullRequest/create", handler.CreatePullRequest)
 	r.Post("/pullRequest/merge", handler.MergePullRequest)
+	r.Post("/pullRequest/ready", handler.MarkReadyPullRequest)
+	r.Post("/pullRequest/close", handler.ClosePullRequest)
+	r.Post("/pullRequest/reopen", handler.ReopenPullRequest)
 	r.Post("/pullRequest/reassign", handler.Reassign)
+	r.Post("/pullRequest/reviewers/add", handler.AddReviewers)
+	r.Post("/pullRequest/reviewers/remove", handler.RemoveReviewers)
+	r.Post("/pullRequest/review", handler.SubmitReview)
+	r.Get("/pullRequest/history", handler.GetPullRequestHistory)
+	r.Get("/pullRequest/assignmentExplanation", handler.GetAssignmentExplanation)
+
+	r.Get("/stats/assignments", handler.GetAssignmentStats)
+
+	r.Get("/events/stream", handler.StreamEvents)
+
+	r.Post("/webhooks/add", handler.AddWebhook)
+	r.Get("/webhooks/list", handler.GetWebhooks)
+	r.Post("/webhooks/remove", handler.RemoveWebhook)
+	r.Get("/webhooks/deadLetters", handler.GetWebhookDeadLetters)
+	r.Post("/webhooks/inbound/github", handler.GitHubWebhook)
+	r.Post("/webhooks/inbound/gitlab", handler.GitLabWebhook)
+
+	go serveGRPC(cfg, uc)
 
 	log.Println("Servise started on port", handler.AppPort)
 	log.Fatal(http.ListenAndServe(handler.AppPort, r))
 }
+
+// serveGRPC serves the gRPC API on its own port with the usecase of the HTTP
+// router.
+func serveGRPC(cfg *config.Config, uc usecase.UsecaseInterface) {
+	lis, err := net.Listen("tcp", cfg.Server.GRPCPort)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(rpc.UnaryInterceptor(cfg.Admins)))
+	rpc.Register(srv, uc)
+	reflection.Register(srv)
+
+	log.Println("gRPC service started on port", cfg.Server.GRPCPort)
+	log.Fatal(srv.Serve(lis))
+}
+
+const notifyTimeout = 10 * time.Second
+
+// newNotifier sends review notifications to Slack, and by email when an SMTP
+// server is configured.
+func newNotifier(cfg *config.Config) notify.Notifier {
+	notifiers := notify.Multi{notify.NewSlack(notifyTimeout)}
+	if cfg.SMTP.Host != "" {
+		notifiers = append(notifiers, notify.NewSMTP(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.From, notifyTimeout))
+	}
+	return notifiers
+}
@@ -3,6 +3,13 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultUnavailabilityCheckInterval = time.Minute
+	defaultWebhookDispatchInterval     = 5 * time.Second
 )
 
 type Config struct {
@@ -15,8 +22,35 @@ type Config struct {
 	}
 
 	Server struct {
-		Port string
+		Port     string
+		GRPCPort string
+	}
+
+	// SMTP is the mail server of email notifications, they are off while
+	// Host is empty.
+	SMTP struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
 	}
+
+	// Admins are the actor ids allowed to force merges. The ids come from
+	// the X-Actor-Id header, which must be set by a trusted proxy. Empty by
+	// default.
+	Admins []string
+
+	// UnavailabilityCheckInterval is how often open reviews of users whose
+	// unavailability started are moved to someone else.
+	UnavailabilityCheckInterval time.Duration
+
+	// WebhookDispatchInterval is how often due webhook deliveries are sent.
+	WebhookDispatchInterval time.Duration
+
+	// InboundWebhookSecret verifies pull request webhooks of GitHub and
+	// GitLab; while it is empty every inbound webhook is rejected.
+	InboundWebhookSecret string
 }
 
 func LoadConfig() *Config {
@@ -36,9 +70,49 @@ func LoadConfig() *Config {
 			Name:     os.Getenv("DB_NAME"),
 		},
 		Server: struct {
-			Port string
+			Port     string
+			GRPCPort string
+		}{
+			Port:     fmt.Sprintf(":%s", os.Getenv("APP_PORT")),
+			GRPCPort: fmt.Sprintf(":%s", os.Getenv("GRPC_PORT")),
+		},
+		SMTP: struct {
+			Host     string
+			Port     string
+			Username string
+			Password string
+			From     string
 		}{
-			Port: fmt.Sprintf(":%s", os.Getenv("APP_PORT")),
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
 		},
+		Admins:                      splitList(os.Getenv("ADMIN_IDS")),
+		UnavailabilityCheckInterval: parseInterval(os.Getenv("UNAVAILABILITY_CHECK_INTERVAL"), defaultUnavailabilityCheckInterval),
+		WebhookDispatchInterval:     parseInterval(os.Getenv("WEBHOOK_DISPATCH_INTERVAL"), defaultWebhookDispatchInterval),
+		InboundWebhookSecret:        os.Getenv("INBOUND_WEBHOOK_SECRET"),
+	}
+}
+
+// parseInterval parses a duration env value such as "30s", falling back to
+// def when it is empty or not a positive duration.
+func parseInterval(value string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// splitList parses a comma separated env value, skipping empty items.
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
+	return items
 }
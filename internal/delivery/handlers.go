@@ -2,27 +2,34 @@ package delivery
 
 import (
 	"PRmanager/config"
+	"PRmanager/internal/delivery/inbound"
 	"PRmanager/internal/delivery/response"
 	"PRmanager/internal/models"
 	"PRmanager/internal/usecase"
 	"PRmanager/pkg/logs"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	appErrors "PRmanager/pkg/app_errors"
 )
 
 type Handler struct {
-	usecase usecase.UsecaseInterface
-	AppPort string
+	usecase       usecase.UsecaseInterface
+	AppPort       string
+	inboundSecret string
 }
 
 func NewHandler(usecase usecase.UsecaseInterface, config *config.Config) *Handler {
 	return &Handler{
-		usecase: usecase,
-		AppPort: config.Server.Port,
+		usecase:       usecase,
+		AppPort:       config.Server.Port,
+		inboundSecret: config.InboundWebhookSecret,
 	}
 }
 
@@ -42,6 +49,48 @@ func (h *Handler) AddTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if errors.Is(err, appErrors.ErrUnknownStrategy) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownStrategy, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidReviewersCount) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidReviewersCount, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidRequiredApprovals) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidRequiredApprovals, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidTag) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidTag, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrUnknownReassignPool) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownReassignPool, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidCapacity) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidCapacity, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidPairingLookback) {
+		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidPairingLookback, w)
+		return
+	}
+
 	if err != nil {
 		logs.PrintLog(r.Context(), "[delivery] AddTeam", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
@@ -77,143 +126,1516 @@ func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	logs.PrintLog(r.Context(), "[delivery] GetTeam", fmt.Sprintf("Team found: %+v", team.TeamName))
 }
 
-func (h *Handler) SetIsActive(w http.ResponseWriter, r *http.Request) {
-	var InputData models.SetIsActiveDTO
+func (h *Handler) SetReviewerStrategy(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetReviewerStrategyDTO
 	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReviewerStrategy", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
 		return
 	}
 
-	userDto, err := h.usecase.SetIsActive(r.Context(), &InputData)
+	team, err := h.usecase.SetReviewerStrategy(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrUnknownStrategy) {
+		logs.PrintLog(r.Context(), "[delivery] SetReviewerStrategy", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownStrategy, w)
+		return
+	}
+
 	if errors.Is(err, appErrors.ErrResourceNotFound) {
-		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReviewerStrategy", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
 		return
 	}
 
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReviewerStrategy", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
-	response.SendOkResonseUser(r.Context(), userDto, w)
-	logs.PrintLog(r.Context(), "[delivery] SetIsActive", fmt.Sprintf("Member updated: %+v set isActive to: %+v", InputData.UserID, InputData.IsActive))
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetReviewerStrategy", fmt.Sprintf("Team %+v uses strategy: %+v", InputData.TeamName, InputData.ReviewerStrategy))
 }
 
-func (h *Handler) GetReview(w http.ResponseWriter, r *http.Request) {
-	userSystemId := r.URL.Query().Get("user_id")
-	if userSystemId == "" {
-		logs.PrintLog(r.Context(), "[delivery] GetReview", appErrors.ErrParseData.Error())
+func (h *Handler) SetReviewersCount(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetReviewersCountDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetReviewersCount", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
 		return
 	}
 
-	review, err := h.usecase.GetReview(r.Context(), userSystemId)
+	team, err := h.usecase.SetReviewersCount(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidReviewersCount) {
+		logs.PrintLog(r.Context(), "[delivery] SetReviewersCount", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidReviewersCount, w)
+		return
+	}
+
 	if errors.Is(err, appErrors.ErrResourceNotFound) {
-		logs.PrintLog(r.Context(), "[delivery] GetReview", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReviewersCount", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
 		return
 	}
 
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] GetReview", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReviewersCount", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
-	response.SendOkResonseReview(r.Context(), review, w)
-	logs.PrintLog(r.Context(), "[delivery] GetReview", fmt.Sprintf("Review found for user: %+v", userSystemId))
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetReviewersCount", fmt.Sprintf("Team %+v reviewers count: %+v-%+v", InputData.TeamName, InputData.MinReviewers, InputData.MaxReviewers))
 }
 
-func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
-	var InputData models.InputCreatePullRequestDTO
+func (h *Handler) SetRequiredApprovals(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetRequiredApprovalsDTO
 	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetRequiredApprovals", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
 		return
 	}
 
-	pr, err := h.usecase.CreatePullRequest(r.Context(), &InputData)
+	team, err := h.usecase.SetRequiredApprovals(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidRequiredApprovals) {
+		logs.PrintLog(r.Context(), "[delivery] SetRequiredApprovals", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidRequiredApprovals, w)
+		return
+	}
+
 	if errors.Is(err, appErrors.ErrResourceNotFound) {
-		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetRequiredApprovals", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
 		return
 	}
 
-	if errors.Is(err, appErrors.ErrPullRequestExists) {
-		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
-		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestExists, w)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetRequiredApprovals", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetRequiredApprovals", fmt.Sprintf("Team %+v required approvals: %+v", InputData.TeamName, InputData.RequiredApprovals))
+}
+
+func (h *Handler) SetMergePolicy(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetMergePolicyDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetMergePolicy", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	team, err := h.usecase.SetMergePolicy(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SetMergePolicy", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetMergePolicy", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
-	response.SendOkResonseCreatePullRequest(r.Context(), pr, w)
-	logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", fmt.Sprintf("PullRequest created: %+v", InputData.PullRequestName))
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetMergePolicy", fmt.Sprintf("Team %+v merge policy: %+v", InputData.TeamName, InputData.MergePolicy))
 }
 
-func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
-	var InputData models.InputMergePullRequestDTO
+func (h *Handler) SetReassignPool(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetReassignPoolDTO
 	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReassignPool", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
 		return
 	}
 
-	pr, err := h.usecase.MergePullRequest(r.Context(), &InputData)
+	team, err := h.usecase.SetReassignPool(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrUnknownReassignPool) {
+		logs.PrintLog(r.Context(), "[delivery] SetReassignPool", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownReassignPool, w)
+		return
+	}
+
 	if errors.Is(err, appErrors.ErrResourceNotFound) {
-		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReassignPool", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
 		return
 	}
 
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetReassignPool", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
-	response.SendOkResonseMergePullRequest(r.Context(), pr, w)
-	logs.PrintLog(r.Context(), "[delivery] MergePullRequest", fmt.Sprintf("PullRequest merged: %+v", InputData.PullRequestId))
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetReassignPool", fmt.Sprintf("Team %+v reassign pool: %+v", InputData.TeamName, InputData.ReassignPool))
 }
 
-func (h *Handler) Reassign(w http.ResponseWriter, r *http.Request) {
-	var InputData models.InputReassignDTO
+func (h *Handler) SetPairingLookback(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetPairingLookbackDTO
 	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetPairingLookback", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
 		return
 	}
 
-	pr, err := h.usecase.Reassign(r.Context(), &InputData)
+	team, err := h.usecase.SetPairingLookback(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidPairingLookback) {
+		logs.PrintLog(r.Context(), "[delivery] SetPairingLookback", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidPairingLookback, w)
+		return
+	}
+
 	if errors.Is(err, appErrors.ErrResourceNotFound) {
-		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetPairingLookback", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
 		return
 	}
 
-	if errors.Is(err, appErrors.ErrPullRequestMerged) {
-		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
-		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetPairingLookback", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetPairingLookback", fmt.Sprintf("Team %+v pairing lookback: %+v days", InputData.TeamName, InputData.PairingLookbackDays))
+}
+
+func (h *Handler) SetPartnerTeams(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetPartnerTeamsDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
 	if err != nil {
-		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		logs.PrintLog(r.Context(), "[delivery] SetPartnerTeams", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	team, err := h.usecase.SetPartnerTeams(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidPartnerTeam) {
+		logs.PrintLog(r.Context(), "[delivery] SetPartnerTeams", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidPartnerTeam, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SetPartnerTeams", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetPartnerTeams", err.Error())
 		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
 		return
 	}
 
-	response.SendOkResonseReassign(r.Context(), pr, w)
-	logs.PrintLog(r.Context(), "[delivery] Reassign", fmt.Sprintf("PullRequest reasigned: %+v", InputData.PullRequestId))
+	response.SendOkResonseTeam(r.Context(), team, w)
+	logs.PrintLog(r.Context(), "[delivery] SetPartnerTeams", fmt.Sprintf("Team %+v partners: %+v", InputData.TeamName, InputData.PartnerTeams))
+}
+
+func (h *Handler) AddOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	var InputData models.OwnershipRuleDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	rule, err := h.usecase.AddOwnershipRule(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidOwnershipPattern) {
+		logs.PrintLog(r.Context(), "[delivery] AddOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidOwnershipPattern, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] AddOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseOwnershipRuleCreated(r.Context(), rule, w)
+	logs.PrintLog(r.Context(), "[delivery] AddOwnershipRule", fmt.Sprintf("Rule added: %+v", rule.RuleId))
+}
+
+func (h *Handler) GetOwnershipRules(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetOwnershipRules", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	rules, err := h.usecase.GetOwnershipRules(r.Context(), teamName)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetOwnershipRules", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetOwnershipRules", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseOwnershipRules(r.Context(), rules, w)
+	logs.PrintLog(r.Context(), "[delivery] GetOwnershipRules", fmt.Sprintf("Rules of team %+v: %+v", teamName, len(rules.Rules)))
+}
+
+func (h *Handler) UpdateOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	var InputData models.OwnershipRuleDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] UpdateOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	rule, err := h.usecase.UpdateOwnershipRule(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidOwnershipPattern) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidOwnershipPattern, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] UpdateOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseOwnershipRule(r.Context(), rule, w)
+	logs.PrintLog(r.Context(), "[delivery] UpdateOwnershipRule", fmt.Sprintf("Rule updated: %+v", rule.RuleId))
+}
+
+func (h *Handler) DeleteOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	var InputData models.DeleteOwnershipRuleDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] DeleteOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	err = h.usecase.DeleteOwnershipRule(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] DeleteOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] DeleteOwnershipRule", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOKResponse(w)
+	logs.PrintLog(r.Context(), "[delivery] DeleteOwnershipRule", fmt.Sprintf("Rule deleted: %+v", InputData.RuleId))
+}
+
+func (h *Handler) UpdateTeamMembers(w http.ResponseWriter, r *http.Request) {
+	var InputData models.UpdateTeamMembersDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	result, err := h.usecase.UpdateTeamMembers(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidTag) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidTag, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidCapacity) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidCapacity, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrUnknownReviewsPolicy) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownReviewsPolicy, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseTeamMembersUpdated(r.Context(), result, w)
+	logs.PrintLog(r.Context(), "[delivery] UpdateTeamMembers", fmt.Sprintf("Team members updated: %+v", InputData.TeamName))
+}
+
+func (h *Handler) RemoveTeamMembers(w http.ResponseWriter, r *http.Request) {
+	var InputData models.RemoveTeamMembersDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	result, err := h.usecase.RemoveTeamMembers(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrUnknownReviewsPolicy) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownReviewsPolicy, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveTeamMembers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseTeamMembersUpdated(r.Context(), result, w)
+	logs.PrintLog(r.Context(), "[delivery] RemoveTeamMembers", fmt.Sprintf("Team members removed: %+v %+v", InputData.TeamName, InputData.UserIds))
+}
+
+func (h *Handler) MoveUser(w http.ResponseWriter, r *http.Request) {
+	var InputData models.MoveUserDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MoveUser", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	result, err := h.usecase.MoveUser(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrUnknownReviewsPolicy) {
+		logs.PrintLog(r.Context(), "[delivery] MoveUser", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownReviewsPolicy, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] MoveUser", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MoveUser", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseTeamMembersUpdated(r.Context(), result, w)
+	logs.PrintLog(r.Context(), "[delivery] MoveUser", fmt.Sprintf("User %+v moved to team: %+v", InputData.UserId, InputData.TeamName))
+}
+
+func (h *Handler) SetIsActive(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetIsActiveDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	userDto, err := h.usecase.SetIsActive(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetIsActive", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseUser(r.Context(), userDto, w)
+	logs.PrintLog(r.Context(), "[delivery] SetIsActive", fmt.Sprintf("Member updated: %+v set isActive to: %+v", InputData.UserID, InputData.IsActive))
+}
+
+func (h *Handler) SetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var InputData models.SetMaxOpenReviewsDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetMaxOpenReviews", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	userDto, err := h.usecase.SetMaxOpenReviews(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidCapacity) {
+		logs.PrintLog(r.Context(), "[delivery] SetMaxOpenReviews", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidCapacity, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SetMaxOpenReviews", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetMaxOpenReviews", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseUser(r.Context(), userDto, w)
+	logs.PrintLog(r.Context(), "[delivery] SetMaxOpenReviews", fmt.Sprintf("Member updated: %+v max open reviews: %+v", InputData.UserId, userDto.MaxOpenReviews))
+}
+
+func (h *Handler) SetContacts(w http.ResponseWriter, r *http.Request) {
+	var InputData models.UserContactsDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	contacts, err := h.usecase.SetContacts(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidContact) {
+		logs.PrintLog(r.Context(), "[delivery] SetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidContact, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseContacts(r.Context(), contacts, w)
+	logs.PrintLog(r.Context(), "[delivery] SetContacts", fmt.Sprintf("Contacts updated: %+v", InputData.UserId))
+}
+
+func (h *Handler) GetContacts(w http.ResponseWriter, r *http.Request) {
+	userSystemId := r.URL.Query().Get("user_id")
+	if userSystemId == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetContacts", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	contacts, err := h.usecase.GetContacts(r.Context(), userSystemId)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetContacts", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseContacts(r.Context(), contacts, w)
+	logs.PrintLog(r.Context(), "[delivery] GetContacts", fmt.Sprintf("Contacts found for user: %+v", userSystemId))
+}
+
+// userTags decodes a tags request and applies it to the user with apply.
+func (h *Handler) userTags(w http.ResponseWriter, r *http.Request, name string, apply func(context.Context, *models.UserTagsDTO) (*models.UserDTO, error)) {
+	var InputData models.UserTagsDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), name, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	userDto, err := apply(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidTag) {
+		logs.PrintLog(r.Context(), name, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidTag, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), name, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), name, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseUser(r.Context(), userDto, w)
+	logs.PrintLog(r.Context(), name, fmt.Sprintf("User %+v tags: %+v", userDto.UserId, userDto.Tags))
+}
+
+func (h *Handler) SetUserTags(w http.ResponseWriter, r *http.Request) {
+	h.userTags(w, r, "[delivery] SetUserTags", h.usecase.SetUserTags)
+}
+
+func (h *Handler) AddUserTags(w http.ResponseWriter, r *http.Request) {
+	h.userTags(w, r, "[delivery] AddUserTags", h.usecase.AddUserTags)
+}
+
+func (h *Handler) RemoveUserTags(w http.ResponseWriter, r *http.Request) {
+	h.userTags(w, r, "[delivery] RemoveUserTags", h.usecase.RemoveUserTags)
+}
+
+func (h *Handler) AddUnavailability(w http.ResponseWriter, r *http.Request) {
+	var InputData models.UnavailabilityDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	period, err := h.usecase.AddUnavailability(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] AddUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidPeriod) {
+		logs.PrintLog(r.Context(), "[delivery] AddUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidPeriod, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] AddUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseUnavailabilityCreated(r.Context(), period, w)
+	logs.PrintLog(r.Context(), "[delivery] AddUnavailability", fmt.Sprintf("Period added: %+v", period.PeriodId))
+}
+
+func (h *Handler) GetUnavailability(w http.ResponseWriter, r *http.Request) {
+	userSystemId := r.URL.Query().Get("user_id")
+	if userSystemId == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetUnavailability", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	periods, err := h.usecase.GetUnavailability(r.Context(), userSystemId)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseUserUnavailability(r.Context(), periods, w)
+	logs.PrintLog(r.Context(), "[delivery] GetUnavailability", fmt.Sprintf("Periods found for user: %+v", userSystemId))
+}
+
+func (h *Handler) RemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+	var InputData models.RemoveUnavailabilityDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	err = h.usecase.RemoveUnavailability(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveUnavailability", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOKResponse(w)
+	logs.PrintLog(r.Context(), "[delivery] RemoveUnavailability", fmt.Sprintf("Period removed: %+v", InputData.PeriodId))
+}
+
+func (h *Handler) BulkDeactivate(w http.ResponseWriter, r *http.Request) {
+	var InputData models.BulkDeactivateDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] BulkDeactivate", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	result, err := h.usecase.BulkDeactivate(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] BulkDeactivate", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] BulkDeactivate", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] BulkDeactivate", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseBulkDeactivate(r.Context(), result, w)
+	logs.PrintLog(r.Context(), "[delivery] BulkDeactivate", fmt.Sprintf("Users deactivated: %+v", result.DeactivatedUsers))
+}
+
+func (h *Handler) GetReview(w http.ResponseWriter, r *http.Request) {
+	userSystemId := r.URL.Query().Get("user_id")
+	if userSystemId == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetReview", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	review, err := h.usecase.GetReview(r.Context(), userSystemId)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseReview(r.Context(), review, w)
+	logs.PrintLog(r.Context(), "[delivery] GetReview", fmt.Sprintf("Review found for user: %+v", userSystemId))
+}
+
+func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputCreatePullRequestDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.CreatePullRequest(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidTag) {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidTag, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestExists) {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestExists, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrInvalidReviewersCount) {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidReviewersCount, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseCreatePullRequest(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] CreatePullRequest", fmt.Sprintf("PullRequest created: %+v", InputData.PullRequestName))
+}
+
+func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputMergePullRequestDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.MergePullRequest(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		httpErr := appErrors.HttpErrInvalidStatusTransition
+		httpErr.Message = transitionErr.Error()
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	var policyErr *appErrors.MergePolicyError
+	if errors.As(err, &policyErr) {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		httpErr := appErrors.HttpErrMergePolicyViolated
+		httpErr.Violations = policyErr.Violations
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrForbidden) {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrForbidden, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MergePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseMergePullRequest(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] MergePullRequest", fmt.Sprintf("PullRequest merged: %+v", InputData.PullRequestId))
+}
+
+func (h *Handler) Reassign(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputReassignDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.Reassign(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestMerged) {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestClosed) {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestClosed, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerIsAuthor) {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerIsAuthor, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerAlreadyAssigned) {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerAlreadyAssigned, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] Reassign", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseReassign(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] Reassign", fmt.Sprintf("PullRequest reasigned: %+v", InputData.PullRequestId))
+}
+
+func (h *Handler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputSubmitReviewDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	review, err := h.usecase.SubmitReview(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrUnknownDecision) {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrUnknownDecision, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestMerged) {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestClosed) {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestClosed, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerNotAssigned) {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerNotAssigned, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] SubmitReview", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseSubmitReview(r.Context(), review, w)
+	logs.PrintLog(r.Context(), "[delivery] SubmitReview", fmt.Sprintf("Review %+v submitted on %+v", InputData.Decision, InputData.PullRequestId))
+}
+
+func (h *Handler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+	prSystemId := r.URL.Query().Get("pull_request_id")
+	if prSystemId == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetPullRequestHistory", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	history, err := h.usecase.GetPullRequestHistory(r.Context(), prSystemId)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetPullRequestHistory", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetPullRequestHistory", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonsePullRequestHistory(r.Context(), history, w)
+	logs.PrintLog(r.Context(), "[delivery] GetPullRequestHistory", fmt.Sprintf("History found for PullRequest: %+v", prSystemId))
+}
+
+func (h *Handler) GetAssignmentExplanation(w http.ResponseWriter, r *http.Request) {
+	prSystemId := r.URL.Query().Get("pull_request_id")
+	if prSystemId == "" {
+		logs.PrintLog(r.Context(), "[delivery] GetAssignmentExplanation", appErrors.ErrParseData.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	explanation, err := h.usecase.GetAssignmentExplanation(r.Context(), prSystemId)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] GetAssignmentExplanation", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetAssignmentExplanation", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseAssignmentExplanation(r.Context(), explanation, w)
+	logs.PrintLog(r.Context(), "[delivery] GetAssignmentExplanation", fmt.Sprintf("Assignment explanation found for PullRequest: %+v", prSystemId))
+}
+
+func (h *Handler) AddWebhook(w http.ResponseWriter, r *http.Request) {
+	var InputData models.WebhookDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	webhook, err := h.usecase.AddWebhook(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrInvalidWebhook) {
+		logs.PrintLog(r.Context(), "[delivery] AddWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidWebhook, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseWebhookCreated(r.Context(), webhook, w)
+	logs.PrintLog(r.Context(), "[delivery] AddWebhook", fmt.Sprintf("Webhook added: %+v", webhook.SubscriptionId))
+}
+
+func (h *Handler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.usecase.GetWebhooks(r.Context())
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetWebhooks", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseWebhooks(r.Context(), webhooks, w)
+	logs.PrintLog(r.Context(), "[delivery] GetWebhooks", fmt.Sprintf("Webhooks found: %+v", len(webhooks.Webhooks)))
+}
+
+func (h *Handler) RemoveWebhook(w http.ResponseWriter, r *http.Request) {
+	var InputData models.RemoveWebhookDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	err = h.usecase.RemoveWebhook(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOKResponse(w)
+	logs.PrintLog(r.Context(), "[delivery] RemoveWebhook", fmt.Sprintf("Webhook removed: %+v", InputData.SubscriptionId))
+}
+
+func (h *Handler) GetWebhookDeadLetters(w http.ResponseWriter, r *http.Request) {
+	deadLetters, err := h.usecase.GetWebhookDeadLetters(r.Context())
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetWebhookDeadLetters", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseWebhookDeadLetters(r.Context(), deadLetters, w)
+	logs.PrintLog(r.Context(), "[delivery] GetWebhookDeadLetters", fmt.Sprintf("Dead deliveries found: %+v", len(deadLetters.Deliveries)))
+}
+
+func (h *Handler) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitHubWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if err := inbound.VerifyGitHub(h.inboundSecret, body, r.Header.Get(inbound.GitHubSignatureHeader)); err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitHubWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidSignature, w)
+		return
+	}
+
+	event, err := inbound.ParseGitHub(r.Header.Get(inbound.GitHubEventHeader), r.Header.Get(inbound.GitHubDeliveryHeader), body)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitHubWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, "GitHubWebhook", event)
+}
+
+func (h *Handler) GitLabWebhook(w http.ResponseWriter, r *http.Request) {
+	if err := inbound.VerifyGitLab(h.inboundSecret, r.Header.Get(inbound.GitLabTokenHeader)); err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitLabWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrInvalidSignature, w)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitLabWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	event, err := inbound.ParseGitLab(r.Header.Get(inbound.GitLabEventHeader), r.Header.Get(inbound.GitLabDeliveryHeader), body)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GitLabWebhook", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, "GitLabWebhook", event)
+}
+
+// handlePullRequestEvent applies a verified inbound event and answers the
+// code host.
+func (h *Handler) handlePullRequestEvent(w http.ResponseWriter, r *http.Request, name string, event *models.InboundPullRequestEvent) {
+	place := fmt.Sprintf("[delivery] %s", name)
+
+	result, err := h.usecase.HandlePullRequestEvent(r.Context(), event)
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		httpErr := appErrors.HttpErrInvalidStatusTransition
+		httpErr.Message = transitionErr.Error()
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestMerged) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestClosed) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestClosed, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestDraft) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestDraft, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerIsAuthor) {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerIsAuthor, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), place, err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseInboundWebhook(r.Context(), result, w)
+	logs.PrintLog(r.Context(), place, fmt.Sprintf("Delivery %+v %+v: %+v", result.DeliveryId, result.Action, result.Result))
+}
+
+func (h *Handler) GetAssignmentStats(w http.ResponseWriter, r *http.Request) {
+	InputData := models.InputAssignmentStatsDTO{
+		From:     r.URL.Query().Get("from"),
+		To:       r.URL.Query().Get("to"),
+		TeamName: r.URL.Query().Get("team_name"),
+	}
+
+	stats, err := h.usecase.GetAssignmentStats(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] GetAssignmentStats", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] GetAssignmentStats", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseAssignmentStats(r.Context(), stats, w)
+	logs.PrintLog(r.Context(), "[delivery] GetAssignmentStats", fmt.Sprintf("Stats sent for window: %+v - %+v", InputData.From, InputData.To))
+}
+
+// streamKeepAlive is how often an idle event stream gets a comment line.
+const streamKeepAlive = 15 * time.Second
+
+// StreamEvents streams live review activity as Server-Sent Events. Events a
+// reconnecting client missed are sent first, found by its Last-Event-ID.
+func (h *Handler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		logs.PrintLog(r.Context(), "[delivery] StreamEvents", "response writer can't flush")
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	InputData := models.LiveEventFilterDTO{
+		TeamName: r.URL.Query().Get("team_name"),
+		UserId:   r.URL.Query().Get("user_id"),
+	}
+
+	lastEventId := r.Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = r.URL.Query().Get("last_event_id")
+	}
+
+	if lastEventId != "" {
+		id, err := strconv.ParseInt(lastEventId, 10, 64)
+		if err != nil || id < 0 {
+			logs.PrintLog(r.Context(), "[delivery] StreamEvents", fmt.Sprintf("Bad last event id: %+v", lastEventId))
+			response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+			return
+		}
+		InputData.LastEventId = id
+	}
+
+	sub := h.usecase.SubscribeEvents(r.Context(), &InputData)
+	defer sub.Close()
+
+	if err := response.StartEventStream(w); err != nil {
+		logs.PrintLog(r.Context(), "[delivery] StreamEvents", err.Error())
+		return
+	}
+
+	for i := range sub.Missed {
+		if err := response.SendLiveEvent(w, &sub.Missed[i]); err != nil {
+			logs.PrintLog(r.Context(), "[delivery] StreamEvents", err.Error())
+			return
+		}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(streamKeepAlive)
+	defer ticker.Stop()
+
+	sent := len(sub.Missed)
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			logs.PrintLog(r.Context(), "[delivery] StreamEvents", fmt.Sprintf("Client left, events sent: %+v", sent))
+			return
+		case e, ok := <-sub.Events:
+			if !ok {
+				// the client fell behind, it catches up when it reconnects
+				logs.PrintLog(r.Context(), "[delivery] StreamEvents", fmt.Sprintf("Subscriber dropped, events sent: %+v", sent))
+				return
+			}
+			err = response.SendLiveEvent(w, &e)
+			sent++
+		case <-ticker.C:
+			err = response.SendKeepAlive(w)
+		}
+
+		if err != nil {
+			logs.PrintLog(r.Context(), "[delivery] StreamEvents", err.Error())
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (h *Handler) MarkReadyPullRequest(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputChangeStatusPullRequestDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.MarkReadyPullRequest(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", err.Error())
+		httpErr := appErrors.HttpErrInvalidStatusTransition
+		httpErr.Message = transitionErr.Error()
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseChangeStatusPullRequest(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] MarkReadyPullRequest", fmt.Sprintf("PullRequest marked ready: %+v", InputData.PullRequestId))
+}
+
+func (h *Handler) ClosePullRequest(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputChangeStatusPullRequestDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.ClosePullRequest(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", err.Error())
+		httpErr := appErrors.HttpErrInvalidStatusTransition
+		httpErr.Message = transitionErr.Error()
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseChangeStatusPullRequest(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] ClosePullRequest", fmt.Sprintf("PullRequest closed: %+v", InputData.PullRequestId))
+}
+
+func (h *Handler) ReopenPullRequest(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputChangeStatusPullRequestDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.ReopenPullRequest(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", err.Error())
+		httpErr := appErrors.HttpErrInvalidStatusTransition
+		httpErr.Message = transitionErr.Error()
+		response.SendErrorResponse(r.Context(), httpErr, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseChangeStatusPullRequest(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] ReopenPullRequest", fmt.Sprintf("PullRequest reopened: %+v", InputData.PullRequestId))
+}
+
+func (h *Handler) AddReviewers(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputChangeReviewersDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.AddReviewers(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestMerged) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestClosed) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestClosed, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestDraft) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestDraft, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerIsAuthor) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerIsAuthor, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerAlreadyAssigned) {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerAlreadyAssigned, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] AddReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseChangeReviewers(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] AddReviewers", fmt.Sprintf("PullRequest %+v reviewers added: %+v", InputData.PullRequestId, InputData.ReviewerIds))
+}
+
+func (h *Handler) RemoveReviewers(w http.ResponseWriter, r *http.Request) {
+	var InputData models.InputChangeReviewersDTO
+	err := json.NewDecoder(r.Body).Decode(&InputData)
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	pr, err := h.usecase.RemoveReviewers(r.Context(), &InputData)
+	if errors.Is(err, appErrors.ErrParseData) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrParseData, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrResourceNotFound) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrNotFound, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestMerged) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestMerged, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestClosed) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestClosed, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrPullRequestDraft) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrPullRequestDraft, w)
+		return
+	}
+
+	if errors.Is(err, appErrors.ErrReviewerNotAssigned) {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpErrReviewerNotAssigned, w)
+		return
+	}
+
+	if err != nil {
+		logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", err.Error())
+		response.SendErrorResponse(r.Context(), appErrors.HttpServerError, w)
+		return
+	}
+
+	response.SendOkResonseChangeReviewers(r.Context(), pr, w)
+	logs.PrintLog(r.Context(), "[delivery] RemoveReviewers", fmt.Sprintf("PullRequest %+v reviewers removed: %+v", InputData.PullRequestId, InputData.ReviewerIds))
 }
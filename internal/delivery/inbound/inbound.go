@@ -0,0 +1,203 @@
+package inbound
+
+import (
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/webhook"
+	"crypto/hmac"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	appErrors "PRmanager/pkg/app_errors"
+)
+
+// Headers of GitHub webhooks.
+const (
+	GitHubEventHeader     = "X-GitHub-Event"
+	GitHubDeliveryHeader  = "X-GitHub-Delivery"
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+)
+
+// Headers of GitLab webhooks.
+const (
+	GitLabEventHeader    = "X-Gitlab-Event"
+	GitLabDeliveryHeader = "X-Gitlab-Event-UUID"
+	GitLabTokenHeader    = "X-Gitlab-Token"
+)
+
+const (
+	gitHubPullRequestEvent  = "pull_request"
+	gitLabMergeRequestEvent = "Merge Request Hook"
+)
+
+// VerifyGitHub checks the HMAC-SHA256 signature GitHub computes over the raw
+// body with the webhook secret. An empty secret accepts nothing.
+func VerifyGitHub(secret string, body []byte, signature string) error {
+	if secret == "" || !hmac.Equal([]byte(webhook.Sign(secret, body)), []byte(signature)) {
+		return appErrors.ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyGitLab checks the token GitLab sends as is. An empty secret accepts
+// nothing.
+func VerifyGitLab(secret string, token string) error {
+	if secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(token)) != 1 {
+		return appErrors.ErrInvalidSignature
+	}
+	return nil
+}
+
+type gitHubUser struct {
+	Login string `json:"login"`
+}
+
+type gitHubPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string     `json:"title"`
+		Draft  bool       `json:"draft"`
+		Merged bool       `json:"merged"`
+		User   gitHubUser `json:"user"`
+	} `json:"pull_request"`
+	RequestedReviewer *gitHubUser `json:"requested_reviewer"`
+	Repository        struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGitHub reads a GitHub webhook. Events other than pull_request come
+// back with the event name as the action, so that they are ignored.
+func ParseGitHub(eventType string, deliveryId string, body []byte) (*models.InboundPullRequestEvent, error) {
+	if deliveryId == "" {
+		return nil, appErrors.ErrParseData
+	}
+
+	event := &models.InboundPullRequestEvent{
+		Provider:   models.ProviderGitHub,
+		DeliveryId: deliveryId,
+		Action:     eventType,
+	}
+
+	if eventType != gitHubPullRequestEvent {
+		return event, nil
+	}
+
+	var payload gitHubPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.Repository.FullName == "" || payload.Number == 0 {
+		return nil, appErrors.ErrParseData
+	}
+
+	event.Action = payload.Action
+	event.PullRequestId = fmt.Sprintf("%s#%d", payload.Repository.FullName, payload.Number)
+	event.Title = payload.PullRequest.Title
+	event.AuthorLogin = payload.PullRequest.User.Login
+	event.Draft = payload.PullRequest.Draft
+
+	switch {
+	case payload.Action == "closed" && payload.PullRequest.Merged:
+		event.Action = models.InboundMerged
+	case payload.Action == models.InboundReviewRequested && payload.RequestedReviewer != nil:
+		event.RequestedReviewers = []string{payload.RequestedReviewer.Login}
+	}
+
+	return event, nil
+}
+
+type gitLabUser struct {
+	Username string `json:"username"`
+}
+
+type gitLabPayload struct {
+	ObjectKind string     `json:"object_kind"`
+	User       gitLabUser `json:"user"`
+	Project    struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Iid            int    `json:"iid"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Reviewers *struct {
+			Previous []gitLabUser `json:"previous"`
+			Current  []gitLabUser `json:"current"`
+		} `json:"reviewers"`
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+var gitLabActions = map[string]string{
+	"open":   models.InboundOpened,
+	"reopen": models.InboundReopened,
+	"close":  models.InboundClosed,
+	"merge":  models.InboundMerged,
+}
+
+// ParseGitLab reads a GitLab merge request webhook. GitLab names the user who
+// triggered the event rather than the author, so the opener is taken as the
+// author. An update is a review request when reviewers were added and a
+// ready for review when the draft flag was dropped; a review request that
+// also drops the flag is marked Ready.
+func ParseGitLab(eventType string, deliveryId string, body []byte) (*models.InboundPullRequestEvent, error) {
+	if deliveryId == "" {
+		return nil, appErrors.ErrParseData
+	}
+
+	event := &models.InboundPullRequestEvent{
+		Provider:   models.ProviderGitLab,
+		DeliveryId: deliveryId,
+		Action:     eventType,
+	}
+
+	if eventType != gitLabMergeRequestEvent {
+		return event, nil
+	}
+
+	var payload gitLabPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.Project.PathWithNamespace == "" || payload.ObjectAttributes.Iid == 0 {
+		return nil, appErrors.ErrParseData
+	}
+
+	attrs := payload.ObjectAttributes
+	event.Action = attrs.Action
+	event.PullRequestId = fmt.Sprintf("%s!%d", payload.Project.PathWithNamespace, attrs.Iid)
+	event.Title = attrs.Title
+	event.AuthorLogin = payload.User.Username
+	event.Draft = attrs.Draft || attrs.WorkInProgress
+
+	if action, ok := gitLabActions[attrs.Action]; ok {
+		event.Action = action
+		return event, nil
+	}
+
+	if attrs.Action != "update" {
+		return event, nil
+	}
+
+	if draft := payload.Changes.Draft; draft != nil && draft.Previous && !draft.Current {
+		event.Action = models.InboundReady
+	}
+
+	if reviewers := payload.Changes.Reviewers; reviewers != nil {
+		for _, current := range reviewers.Current {
+			if !slices.Contains(reviewers.Previous, current) {
+				event.RequestedReviewers = append(event.RequestedReviewers, current.Username)
+			}
+		}
+		if len(event.RequestedReviewers) > 0 {
+			event.Ready = event.Action == models.InboundReady
+			event.Action = models.InboundReviewRequested
+		}
+	}
+
+	return event, nil
+}
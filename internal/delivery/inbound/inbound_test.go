@@ -0,0 +1,214 @@
+package inbound_test
+
+import (
+	"PRmanager/internal/delivery/inbound"
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/webhook"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appErrors "PRmanager/pkg/app_errors"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestParseGitHub(t *testing.T) {
+	tests := []struct {
+		fixture string
+		event   string
+		want    *models.InboundPullRequestEvent
+	}{
+		{
+			fixture: "github_opened.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundOpened,
+				PullRequestId: "octo/app#12",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "github_closed_merged.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundMerged,
+				PullRequestId: "octo/app#12",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "github_closed.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundClosed,
+				PullRequestId: "octo/app#12",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "github_reopened.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundReopened,
+				PullRequestId: "octo/app#12",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "github_review_requested.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:             models.InboundReviewRequested,
+				PullRequestId:      "octo/app#12",
+				Title:              "Add rate limiter",
+				AuthorLogin:        "u1",
+				RequestedReviewers: []string{"u4"},
+			},
+		},
+		{
+			fixture: "github_team_review_requested.json",
+			event:   "pull_request",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundReviewRequested,
+				PullRequestId: "octo/app#12",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "github_ping.json",
+			event:   "ping",
+			want:    &models.InboundPullRequestEvent{Action: "ping"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			tt.want.Provider = models.ProviderGitHub
+			tt.want.DeliveryId = "d-1"
+
+			got, err := inbound.ParseGitHub(tt.event, "d-1", fixture(t, tt.fixture))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("broken payload", func(t *testing.T) {
+		_, err := inbound.ParseGitHub("pull_request", "d-1", []byte(`{"action":`))
+		assert.Equal(t, appErrors.ErrParseData, err)
+	})
+
+	t.Run("no delivery id", func(t *testing.T) {
+		_, err := inbound.ParseGitHub("pull_request", "", fixture(t, "github_opened.json"))
+		assert.Equal(t, appErrors.ErrParseData, err)
+	})
+}
+
+func TestParseGitLab(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    *models.InboundPullRequestEvent
+	}{
+		{
+			fixture: "gitlab_open.json",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundOpened,
+				PullRequestId: "octo/app!7",
+				Title:         "Draft: Add rate limiter",
+				AuthorLogin:   "u1",
+				Draft:         true,
+			},
+		},
+		{
+			fixture: "gitlab_ready.json",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundReady,
+				PullRequestId: "octo/app!7",
+				Title:         "Add rate limiter",
+				AuthorLogin:   "u1",
+			},
+		},
+		{
+			fixture: "gitlab_reviewers.json",
+			want: &models.InboundPullRequestEvent{
+				Action:             models.InboundReviewRequested,
+				PullRequestId:      "octo/app!7",
+				Title:              "Draft: Add rate limiter",
+				AuthorLogin:        "u9",
+				RequestedReviewers: []string{"u4"},
+			},
+		},
+		{
+			fixture: "gitlab_reviewers_first.json",
+			want: &models.InboundPullRequestEvent{
+				Action:             models.InboundReviewRequested,
+				PullRequestId:      "octo/app!7",
+				Title:              "Draft: Add rate limiter",
+				AuthorLogin:        "u9",
+				RequestedReviewers: []string{"u2", "u4"},
+			},
+		},
+		{
+			fixture: "gitlab_ready_reviewers.json",
+			want: &models.InboundPullRequestEvent{
+				Action:             models.InboundReviewRequested,
+				PullRequestId:      "octo/app!7",
+				Title:              "Add rate limiter",
+				AuthorLogin:        "u9",
+				RequestedReviewers: []string{"u4"},
+				Ready:              true,
+			},
+		},
+		{
+			fixture: "gitlab_merge.json",
+			want: &models.InboundPullRequestEvent{
+				Action:        models.InboundMerged,
+				PullRequestId: "octo/app!7",
+				Title:         "Draft: Add rate limiter",
+				AuthorLogin:   "u9",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			tt.want.Provider = models.ProviderGitLab
+			tt.want.DeliveryId = "d-1"
+
+			got, err := inbound.ParseGitLab("Merge Request Hook", "d-1", fixture(t, tt.fixture))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("other hooks are passed through", func(t *testing.T) {
+		got, err := inbound.ParseGitLab("Push Hook", "d-1", []byte(`{}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "Push Hook", got.Action)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	body := fixture(t, "github_opened.json")
+
+	assert.NoError(t, inbound.VerifyGitHub("s3cret", body, webhook.Sign("s3cret", body)))
+	assert.Equal(t, appErrors.ErrInvalidSignature, inbound.VerifyGitHub("s3cret", body, webhook.Sign("other", body)))
+	assert.Equal(t, appErrors.ErrInvalidSignature, inbound.VerifyGitHub("s3cret", body, ""))
+	assert.Equal(t, appErrors.ErrInvalidSignature, inbound.VerifyGitHub("", body, webhook.Sign("", body)))
+
+	assert.NoError(t, inbound.VerifyGitLab("s3cret", "s3cret"))
+	assert.Equal(t, appErrors.ErrInvalidSignature, inbound.VerifyGitLab("s3cret", "guess"))
+	assert.Equal(t, appErrors.ErrInvalidSignature, inbound.VerifyGitLab("", ""))
+}
@@ -12,10 +12,26 @@ type TeamCreatedResponse struct {
 	Team models.TeamDTO `json:"team"`
 }
 
+type OwnershipRuleResponse struct {
+	Rule models.OwnershipRuleDTO `json:"rule"`
+}
+
 type UserResponse struct {
 	User models.UserDTO `json:"user"`
 }
 
+type ContactsResponse struct {
+	Contacts models.UserContactsDTO `json:"contacts"`
+}
+
+type UnavailabilityResponse struct {
+	Period models.UnavailabilityDTO `json:"period"`
+}
+
+type WebhookResponse struct {
+	Webhook models.WebhookDTO `json:"webhook"`
+}
+
 type CreatedPullRequestResponse struct {
 	PullRequest models.OutputCreatePullRequestDTO `json:"pr"`
 }
@@ -24,6 +40,18 @@ type MergedPullRequestResponse struct {
 	PullRequest models.OutputMergePullRequestDTO `json:"pr"`
 }
 
+type ChangedStatusPullRequestResponse struct {
+	PullRequest models.OutputChangeStatusPullRequestDTO `json:"pr"`
+}
+
+type SubmitReviewResponse struct {
+	Review models.OutputSubmitReviewDTO `json:"review"`
+}
+
+type ChangedReviewersResponse struct {
+	PullRequest models.OutputChangeReviewersDTO `json:"pr"`
+}
+
 type ReassignResponse struct {
 	PullRequest models.OutputReassignDTO `json:"pr"`
 }
@@ -45,6 +73,40 @@ func SendOkResonseTeam(ctx context.Context, team *models.TeamDTO, w http.Respons
 	}
 }
 
+func SendOkResonseTeamMembersUpdated(ctx context.Context, result *models.TeamMembersUpdatedDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseTeamMembersUpdated", err.Error())
+	}
+}
+
+func SendOkResonseOwnershipRuleCreated(ctx context.Context, rule *models.OwnershipRuleDTO, w http.ResponseWriter) {
+	response := OwnershipRuleResponse{Rule: *rule}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseOwnershipRuleCreated", err.Error())
+	}
+}
+
+func SendOkResonseOwnershipRule(ctx context.Context, rule *models.OwnershipRuleDTO, w http.ResponseWriter) {
+	response := OwnershipRuleResponse{Rule: *rule}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseOwnershipRule", err.Error())
+	}
+}
+
+func SendOkResonseOwnershipRules(ctx context.Context, rules *models.OwnershipRulesDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(rules); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseOwnershipRules", err.Error())
+	}
+}
+
 func SendOkResonseUser(ctx context.Context, userDto *models.UserDTO, w http.ResponseWriter) {
 	response := UserResponse{User: *userDto}
 	w.Header().Set("Content-Type", "application/json")
@@ -54,6 +116,40 @@ func SendOkResonseUser(ctx context.Context, userDto *models.UserDTO, w http.Resp
 	}
 }
 
+func SendOkResonseContacts(ctx context.Context, contacts *models.UserContactsDTO, w http.ResponseWriter) {
+	response := ContactsResponse{Contacts: *contacts}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseContacts", err.Error())
+	}
+}
+
+func SendOkResonseUnavailabilityCreated(ctx context.Context, period *models.UnavailabilityDTO, w http.ResponseWriter) {
+	response := UnavailabilityResponse{Period: *period}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseUnavailabilityCreated", err.Error())
+	}
+}
+
+func SendOkResonseUserUnavailability(ctx context.Context, periods *models.UserUnavailabilityDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(periods); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseUserUnavailability", err.Error())
+	}
+}
+
+func SendOkResonseBulkDeactivate(ctx context.Context, result *models.BulkDeactivateResultDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseBulkDeactivate", err.Error())
+	}
+}
+
 func SendOkResonseReview(ctx context.Context, review *models.ReviewDTO, w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -80,6 +176,24 @@ func SendOkResonseMergePullRequest(ctx context.Context, pr *models.OutputMergePu
 	}
 }
 
+func SendOkResonseChangeStatusPullRequest(ctx context.Context, pr *models.OutputChangeStatusPullRequestDTO, w http.ResponseWriter) {
+	response := ChangedStatusPullRequestResponse{PullRequest: *pr}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendErrorResponse", err.Error())
+	}
+}
+
+func SendOkResonseChangeReviewers(ctx context.Context, pr *models.OutputChangeReviewersDTO, w http.ResponseWriter) {
+	response := ChangedReviewersResponse{PullRequest: *pr}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendErrorResponse", err.Error())
+	}
+}
+
 func SendOkResonseReassign(ctx context.Context, pr *models.OutputReassignDTO, w http.ResponseWriter) {
 	response := ReassignResponse{PullRequest: *pr}
 	w.Header().Set("Content-Type", "application/json")
@@ -89,6 +203,72 @@ func SendOkResonseReassign(ctx context.Context, pr *models.OutputReassignDTO, w
 	}
 }
 
+func SendOkResonseSubmitReview(ctx context.Context, review *models.OutputSubmitReviewDTO, w http.ResponseWriter) {
+	response := SubmitReviewResponse{Review: *review}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendErrorResponse", err.Error())
+	}
+}
+
+func SendOkResonsePullRequestHistory(ctx context.Context, history *models.PullRequestHistoryDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonsePullRequestHistory", err.Error())
+	}
+}
+
+func SendOkResonseAssignmentExplanation(ctx context.Context, explanation *models.AssignmentExplanationsDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(explanation); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseAssignmentExplanation", err.Error())
+	}
+}
+
+func SendOkResonseAssignmentStats(ctx context.Context, stats *models.AssignmentStatsDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseAssignmentStats", err.Error())
+	}
+}
+
+func SendOkResonseWebhookCreated(ctx context.Context, webhook *models.WebhookDTO, w http.ResponseWriter) {
+	response := WebhookResponse{Webhook: *webhook}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseWebhookCreated", err.Error())
+	}
+}
+
+func SendOkResonseWebhooks(ctx context.Context, webhooks *models.WebhooksDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(webhooks); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseWebhooks", err.Error())
+	}
+}
+
+func SendOkResonseWebhookDeadLetters(ctx context.Context, deadLetters *models.WebhookDeadLettersDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(deadLetters); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseWebhookDeadLetters", err.Error())
+	}
+}
+
+func SendOkResonseInboundWebhook(ctx context.Context, result *models.InboundWebhookResultDTO, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logs.PrintLog(ctx, "[delivery] SendOkResonseInboundWebhook", err.Error())
+	}
+}
+
 func SendOKResponse(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusOK)
 }
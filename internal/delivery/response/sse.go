@@ -0,0 +1,42 @@
+package response
+
+import (
+	"PRmanager/internal/models"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// streamRetry tells EventSource clients how many milliseconds to wait before
+// reconnecting.
+const streamRetry = 3000
+
+// StartEventStream sends the headers of a Server-Sent Events stream.
+func StartEventStream(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", streamRetry)
+	return err
+}
+
+// SendLiveEvent writes the event as an SSE message named after its type. The
+// event id comes back in Last-Event-ID when the client reconnects.
+func SendLiveEvent(w http.ResponseWriter, e *models.LiveEventDTO) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.EventId, e.Type, data)
+	return err
+}
+
+// SendKeepAlive writes a comment line, so proxies keep an idle stream open.
+func SendKeepAlive(w http.ResponseWriter) error {
+	_, err := fmt.Fprint(w, ": keep-alive\n\n")
+	return err
+}
@@ -0,0 +1,381 @@
+package rpc
+
+import (
+	"PRmanager/api"
+	"PRmanager/internal/models"
+)
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+func toIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+// Teams
+
+func mergePolicyFromPb(p *api.MergePolicy) *models.MergePolicyDTO {
+	if p == nil {
+		return nil
+	}
+	return &models.MergePolicyDTO{
+		RequireReviewer:    p.GetRequireReviewer(),
+		NoChangesRequested: p.GetNoChangesRequested(),
+		NoSelfApproval:     p.GetNoSelfApproval(),
+	}
+}
+
+func mergePolicyToPb(p *models.MergePolicyDTO) *api.MergePolicy {
+	if p == nil {
+		return nil
+	}
+	return &api.MergePolicy{
+		RequireReviewer:    p.RequireReviewer,
+		NoChangesRequested: p.NoChangesRequested,
+		NoSelfApproval:     p.NoSelfApproval,
+	}
+}
+
+func membersFromPb(members []*api.Member) []models.MemberDTO {
+	out := make([]models.MemberDTO, 0, len(members))
+	for _, m := range members {
+		out = append(out, models.MemberDTO{
+			UserID:         m.GetUserId(),
+			Username:       m.GetUsername(),
+			IsActive:       m.GetIsActive(),
+			ReviewWeight:   int(m.GetReviewWeight()),
+			Tags:           m.GetTags(),
+			MaxOpenReviews: toIntPtr(m.MaxOpenReviews),
+		})
+	}
+	return out
+}
+
+func membersToPb(members []models.MemberDTO) []*api.Member {
+	out := make([]*api.Member, 0, len(members))
+	for _, m := range members {
+		out = append(out, &api.Member{
+			UserId:         m.UserID,
+			Username:       m.Username,
+			IsActive:       m.IsActive,
+			ReviewWeight:   int32(m.ReviewWeight),
+			Tags:           m.Tags,
+			MaxOpenReviews: toInt32Ptr(m.MaxOpenReviews),
+			OpenReviews:    toInt32Ptr(m.OpenReviews),
+		})
+	}
+	return out
+}
+
+func teamFromPb(t *api.Team) *models.TeamDTO {
+	return &models.TeamDTO{
+		TeamName:            t.GetTeamName(),
+		ReviewerStrategy:    t.GetReviewerStrategy(),
+		MinReviewers:        toIntPtr(t.MinReviewers),
+		MaxReviewers:        toIntPtr(t.MaxReviewers),
+		RequiredApprovals:   toIntPtr(t.RequiredApprovals),
+		MergePolicy:         mergePolicyFromPb(t.GetMergePolicy()),
+		ReassignPool:        t.GetReassignPool(),
+		PairingLookbackDays: toIntPtr(t.PairingLookbackDays),
+		PartnerTeams:        t.GetPartnerTeams(),
+		Members:             membersFromPb(t.GetMembers()),
+	}
+}
+
+func teamToPb(t *models.TeamDTO) *api.Team {
+	return &api.Team{
+		TeamName:            t.TeamName,
+		ReviewerStrategy:    t.ReviewerStrategy,
+		MinReviewers:        toInt32Ptr(t.MinReviewers),
+		MaxReviewers:        toInt32Ptr(t.MaxReviewers),
+		RequiredApprovals:   toInt32Ptr(t.RequiredApprovals),
+		MergePolicy:         mergePolicyToPb(t.MergePolicy),
+		ReassignPool:        t.ReassignPool,
+		PairingLookbackDays: toInt32Ptr(t.PairingLookbackDays),
+		PartnerTeams:        t.PartnerTeams,
+		Members:             membersToPb(t.Members),
+	}
+}
+
+func reviewerChangesToPb(changes []models.ReviewerChangeDTO) []*api.ReviewerChange {
+	out := make([]*api.ReviewerChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &api.ReviewerChange{
+			PullRequestId: c.PullRequestId,
+			OldReviewerId: c.OldReviewerId,
+			ReplacedBy:    c.ReplacedBy,
+		})
+	}
+	return out
+}
+
+func teamMembersUpdatedToPb(u *models.TeamMembersUpdatedDTO) *api.TeamMembersUpdated {
+	return &api.TeamMembersUpdated{
+		Team:       teamToPb(&u.Team),
+		Reassigned: reviewerChangesToPb(u.Reassigned),
+	}
+}
+
+func ownershipRuleFromPb(r *api.OwnershipRule) *models.OwnershipRuleDTO {
+	return &models.OwnershipRuleDTO{
+		RuleId:   int(r.GetRuleId()),
+		TeamName: r.GetTeamName(),
+		Pattern:  r.GetPattern(),
+		Owners:   r.GetOwners(),
+	}
+}
+
+func ownershipRuleToPb(r *models.OwnershipRuleDTO) *api.OwnershipRule {
+	return &api.OwnershipRule{
+		RuleId:   int32(r.RuleId),
+		TeamName: r.TeamName,
+		Pattern:  r.Pattern,
+		Owners:   r.Owners,
+	}
+}
+
+func ownershipRulesToPb(r *models.OwnershipRulesDTO) *api.OwnershipRules {
+	rules := make([]*api.OwnershipRule, 0, len(r.Rules))
+	for i := range r.Rules {
+		rules = append(rules, ownershipRuleToPb(&r.Rules[i]))
+	}
+	return &api.OwnershipRules{TeamName: r.TeamName, Rules: rules}
+}
+
+// Users
+
+func userToPb(u *models.UserDTO) *api.User {
+	return &api.User{
+		UserId:         u.UserId,
+		UserName:       u.UserName,
+		TeamName:       u.TeamName,
+		IsActive:       u.IsActive,
+		Tags:           u.Tags,
+		MaxOpenReviews: toInt32Ptr(u.MaxOpenReviews),
+	}
+}
+
+func contactsToPb(c *models.UserContactsDTO) *api.UserContacts {
+	return &api.UserContacts{
+		UserId:          c.UserId,
+		SlackWebhookUrl: c.SlackWebhookUrl,
+		Email:           c.Email,
+	}
+}
+
+func unavailabilityFromPb(p *api.Unavailability) *models.UnavailabilityDTO {
+	return &models.UnavailabilityDTO{
+		PeriodId: int(p.GetPeriodId()),
+		UserId:   p.GetUserId(),
+		StartsAt: p.GetStartsAt(),
+		EndsAt:   p.GetEndsAt(),
+		Reason:   p.GetReason(),
+	}
+}
+
+func unavailabilityToPb(p *models.UnavailabilityDTO) *api.Unavailability {
+	return &api.Unavailability{
+		PeriodId: int32(p.PeriodId),
+		UserId:   p.UserId,
+		StartsAt: p.StartsAt,
+		EndsAt:   p.EndsAt,
+		Reason:   p.Reason,
+	}
+}
+
+func userUnavailabilityToPb(u *models.UserUnavailabilityDTO) *api.UserUnavailability {
+	periods := make([]*api.Unavailability, 0, len(u.Periods))
+	for i := range u.Periods {
+		periods = append(periods, unavailabilityToPb(&u.Periods[i]))
+	}
+	return &api.UserUnavailability{UserId: u.UserId, Periods: periods}
+}
+
+func bulkDeactivateResultToPb(r *models.BulkDeactivateResultDTO) *api.BulkDeactivateResult {
+	return &api.BulkDeactivateResult{
+		DeactivatedUsers: r.DeactivatedUsers,
+		Reassigned:       reviewerChangesToPb(r.Reassigned),
+		Dropped:          reviewerChangesToPb(r.Dropped),
+		WithoutReviewers: r.WithoutReviewers,
+	}
+}
+
+func reviewToPb(r *models.ReviewDTO) *api.Review {
+	prs := make([]*api.PullRequestShort, 0, len(r.PullRequest))
+	for _, pr := range r.PullRequest {
+		prs = append(prs, &api.PullRequestShort{
+			PullRequestId:   pr.PullRequestId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorId,
+			Status:          pr.Status,
+			Decision:        pr.Decision,
+			DecidedAt:       pr.DecidedAt,
+		})
+	}
+	return &api.Review{UserId: r.UserId, PullRequests: prs}
+}
+
+// Pull requests
+
+func reviewerPoolsToPb(pools []models.ReviewerPoolDTO) []*api.ReviewerPool {
+	out := make([]*api.ReviewerPool, 0, len(pools))
+	for _, p := range pools {
+		out = append(out, &api.ReviewerPool{
+			ReviewerId:  p.ReviewerId,
+			Pool:        p.Pool,
+			TeamName:    p.TeamName,
+			MatchedRule: p.MatchedRule,
+		})
+	}
+	return out
+}
+
+func createdPullRequestToPb(pr *models.OutputCreatePullRequestDTO) *api.CreatedPullRequest {
+	return &api.CreatedPullRequest{
+		PullRequestId:      pr.PullRequestID,
+		PullRequestName:    pr.PullRequestName,
+		AuthorId:           pr.AuthorID,
+		Status:             pr.Status,
+		AssignedReviewers:  pr.AssignedReviewers,
+		ReviewerPools:      reviewerPoolsToPb(pr.ReviewerPools),
+		RequiredReviewers:  int32(pr.RequiredReviewers),
+		NotEnoughReviewers: pr.NotEnoughReviewers,
+		CapacityLimited:    pr.CapacityLimited,
+	}
+}
+
+func mergedPullRequestToPb(pr *models.OutputMergePullRequestDTO) *api.MergedPullRequest {
+	reviews := make([]*api.ReviewerDecision, 0, len(pr.Reviews))
+	for _, r := range pr.Reviews {
+		reviews = append(reviews, &api.ReviewerDecision{
+			ReviewerId: r.ReviewerId,
+			Decision:   r.Decision,
+			DecidedAt:  r.DecidedAt,
+		})
+	}
+
+	return &api.MergedPullRequest{
+		PullRequestId:      pr.PullRequestID,
+		PullRequestName:    pr.PullRequestName,
+		AuthorId:           pr.AuthorID,
+		Status:             pr.Status,
+		AssignedReviewers:  pr.AssignedReviewers,
+		MergedAt:           pr.MergedAt,
+		RequiredReviewers:  int32(pr.RequiredReviewers),
+		NotEnoughReviewers: pr.NotEnoughReviewers,
+		Reviews:            reviews,
+		Approvals:          int32(pr.Approvals),
+		RequiredApprovals:  int32(pr.RequiredApprovals),
+		Forced:             pr.Forced,
+		BypassedRules:      pr.BypassedRules,
+	}
+}
+
+func pullRequestStatusToPb(pr *models.OutputChangeStatusPullRequestDTO) *api.PullRequestStatus {
+	return &api.PullRequestStatus{
+		PullRequestId:      pr.PullRequestID,
+		PullRequestName:    pr.PullRequestName,
+		AuthorId:           pr.AuthorID,
+		Status:             pr.Status,
+		AssignedReviewers:  pr.AssignedReviewers,
+		ReviewerPools:      reviewerPoolsToPb(pr.ReviewerPools),
+		RequiredReviewers:  int32(pr.RequiredReviewers),
+		NotEnoughReviewers: pr.NotEnoughReviewers,
+		CapacityLimited:    pr.CapacityLimited,
+	}
+}
+
+func reassignedPullRequestToPb(pr *models.OutputReassignDTO) *api.ReassignedPullRequest {
+	return &api.ReassignedPullRequest{
+		PullRequestId:      pr.PullRequestID,
+		PullRequestName:    pr.PullRequestName,
+		AuthorId:           pr.AuthorID,
+		Status:             pr.Status,
+		AssignedReviewers:  pr.AssignedReviewers,
+		ReplacedBy:         pr.ReplacedBy,
+		RequiredReviewers:  int32(pr.RequiredReviewers),
+		NotEnoughReviewers: pr.NotEnoughReviewers,
+		CapacityLimited:    pr.CapacityLimited,
+	}
+}
+
+func pullRequestReviewersToPb(pr *models.OutputChangeReviewersDTO) *api.PullRequestReviewers {
+	return &api.PullRequestReviewers{
+		PullRequestId:      pr.PullRequestID,
+		PullRequestName:    pr.PullRequestName,
+		AuthorId:           pr.AuthorID,
+		Status:             pr.Status,
+		AssignedReviewers:  pr.AssignedReviewers,
+		RequiredReviewers:  int32(pr.RequiredReviewers),
+		NotEnoughReviewers: pr.NotEnoughReviewers,
+	}
+}
+
+func submittedReviewToPb(r *models.OutputSubmitReviewDTO) *api.SubmittedReview {
+	return &api.SubmittedReview{
+		PullRequestId: r.PullRequestId,
+		ReviewerId:    r.ReviewerId,
+		Decision:      r.Decision,
+		DecidedAt:     r.DecidedAt,
+	}
+}
+
+func historyToPb(h *models.PullRequestHistoryDTO) *api.PullRequestHistory {
+	events := make([]*api.PullRequestEvent, 0, len(h.Events))
+	for _, e := range h.Events {
+		events = append(events, &api.PullRequestEvent{
+			EventType:     e.EventType,
+			Actor:         e.Actor,
+			OldReviewerId: e.OldReviewerId,
+			NewReviewerId: e.NewReviewerId,
+			Reason:        e.Reason,
+			SelectionSeed: e.Seed,
+			CreatedAt:     e.CreatedAt,
+		})
+	}
+	return &api.PullRequestHistory{PullRequestId: h.PullRequestId, Events: events}
+}
+
+func explanationsToPb(e *models.AssignmentExplanationsDTO) *api.AssignmentExplanations {
+	assignments := make([]*api.AssignmentExplanation, 0, len(e.Assignments))
+	for _, a := range e.Assignments {
+		candidates := make([]*api.AssignmentCandidate, 0, len(a.Candidates))
+		for _, c := range a.Candidates {
+			candidates = append(candidates, &api.AssignmentCandidate{
+				UserId:   c.UserId,
+				Selected: c.Selected,
+				Pool:     c.Pool,
+			})
+		}
+
+		excluded := make([]*api.ExcludedCandidate, 0, len(a.Excluded))
+		for _, c := range a.Excluded {
+			excluded = append(excluded, &api.ExcludedCandidate{
+				UserId: c.UserId,
+				Reason: c.Reason,
+			})
+		}
+
+		assignments = append(assignments, &api.AssignmentExplanation{
+			Trigger:            a.Trigger,
+			Actor:              a.Actor,
+			Strategy:           a.Strategy,
+			SelectionSeed:      a.Seed,
+			ReplacedReviewerId: a.ReplacedReviewerId,
+			SelectedReviewers:  a.SelectedReviewers,
+			Candidates:         candidates,
+			Excluded:           excluded,
+			CreatedAt:          a.CreatedAt,
+		})
+	}
+	return &api.AssignmentExplanations{PullRequestId: e.PullRequestId, Assignments: assignments}
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	appErrors "PRmanager/pkg/app_errors"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain of the ErrorInfo detail of every error.
+const errorDomain = "prmanager"
+
+// rpcErrors maps usecase errors to a gRPC code and to the HTTP error whose
+// code and message the status carries, so both transports report the same.
+var rpcErrors = []struct {
+	err     error
+	code    codes.Code
+	httpErr appErrors.HttpError
+}{
+	{appErrors.ErrParseData, codes.InvalidArgument, appErrors.HttpErrParseData},
+	{appErrors.ErrResourceNotFound, codes.NotFound, appErrors.HttpErrNotFound},
+	{appErrors.ErrTeamExists, codes.AlreadyExists, appErrors.HttpErrTeamExists},
+	{appErrors.ErrPullRequestExists, codes.AlreadyExists, appErrors.HttpErrPullRequestExists},
+	{appErrors.ErrReviewerAlreadyAssigned, codes.AlreadyExists, appErrors.HttpErrReviewerAlreadyAssigned},
+	{appErrors.ErrUnknownStrategy, codes.InvalidArgument, appErrors.HttpErrUnknownStrategy},
+	{appErrors.ErrInvalidReviewersCount, codes.InvalidArgument, appErrors.HttpErrInvalidReviewersCount},
+	{appErrors.ErrUnknownReviewsPolicy, codes.InvalidArgument, appErrors.HttpErrUnknownReviewsPolicy},
+	{appErrors.ErrInvalidRequiredApprovals, codes.InvalidArgument, appErrors.HttpErrInvalidRequiredApprovals},
+	{appErrors.ErrUnknownDecision, codes.InvalidArgument, appErrors.HttpErrUnknownDecision},
+	{appErrors.ErrUnknownReassignPool, codes.InvalidArgument, appErrors.HttpErrUnknownReassignPool},
+	{appErrors.ErrInvalidPairingLookback, codes.InvalidArgument, appErrors.HttpErrInvalidPairingLookback},
+	{appErrors.ErrInvalidCapacity, codes.InvalidArgument, appErrors.HttpErrInvalidCapacity},
+	{appErrors.ErrInvalidPeriod, codes.InvalidArgument, appErrors.HttpErrInvalidPeriod},
+	{appErrors.ErrInvalidTag, codes.InvalidArgument, appErrors.HttpErrInvalidTag},
+	{appErrors.ErrInvalidOwnershipPattern, codes.InvalidArgument, appErrors.HttpErrInvalidOwnershipPattern},
+	{appErrors.ErrInvalidPartnerTeam, codes.InvalidArgument, appErrors.HttpErrInvalidPartnerTeam},
+	{appErrors.ErrInvalidContact, codes.InvalidArgument, appErrors.HttpErrInvalidContact},
+	{appErrors.ErrInvalidWebhook, codes.InvalidArgument, appErrors.HttpErrInvalidWebhook},
+	{appErrors.ErrPullRequestMerged, codes.FailedPrecondition, appErrors.HttpErrPullRequestMerged},
+	{appErrors.ErrPullRequestClosed, codes.FailedPrecondition, appErrors.HttpErrPullRequestClosed},
+	{appErrors.ErrPullRequestDraft, codes.FailedPrecondition, appErrors.HttpErrPullRequestDraft},
+	{appErrors.ErrReviewerIsAuthor, codes.FailedPrecondition, appErrors.HttpErrReviewerIsAuthor},
+	{appErrors.ErrReviewerNotAssigned, codes.FailedPrecondition, appErrors.HttpErrReviewerNotAssigned},
+	{appErrors.ErrInvalidStatusTransition, codes.FailedPrecondition, appErrors.HttpErrInvalidStatusTransition},
+	{appErrors.ErrMergePolicyViolated, codes.FailedPrecondition, appErrors.HttpErrMergePolicyViolated},
+	{appErrors.ErrForbidden, codes.PermissionDenied, appErrors.HttpErrForbidden},
+	{appErrors.ErrInvalidSignature, codes.Unauthenticated, appErrors.HttpErrInvalidSignature},
+}
+
+// toStatus turns a usecase error into a gRPC status error. The HTTP error
+// code goes to an ErrorInfo detail and merge policy violations to a
+// PreconditionFailure one; unknown errors are Internal.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code, httpErr := codes.Internal, appErrors.HttpServerError
+	for _, e := range rpcErrors {
+		if errors.Is(err, e.err) {
+			code, httpErr = e.code, e.httpErr
+			break
+		}
+	}
+
+	var transitionErr *appErrors.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		httpErr.Message = transitionErr.Error()
+	}
+
+	st := status.New(code, httpErr.Message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: httpErr.Code, Domain: errorDomain}}
+
+	var policyErr *appErrors.MergePolicyError
+	if errors.As(err, &policyErr) {
+		failure := &errdetails.PreconditionFailure{}
+		for _, v := range policyErr.Violations {
+			failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        v.Rule,
+				Description: v.Message,
+			})
+		}
+		details = append(details, failure)
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
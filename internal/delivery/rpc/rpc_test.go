@@ -0,0 +1,182 @@
+package rpc_test
+
+import (
+	"PRmanager/api"
+	"PRmanager/internal/delivery/rpc"
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase"
+	"PRmanager/pkg/actor"
+	appErrors "PRmanager/pkg/app_errors"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stubUsecase implements the calls the tests make; any other call panics on
+// the nil embedded interface.
+type stubUsecase struct {
+	usecase.UsecaseInterface
+
+	actorId string
+	isAdmin bool
+	err     error
+}
+
+func (s *stubUsecase) GetTeamByName(ctx context.Context, teamName string) (*models.TeamDTO, error) {
+	s.actorId, s.isAdmin = actor.FromContext(ctx), actor.IsAdmin(ctx)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &models.TeamDTO{
+		TeamName: teamName,
+		Members:  []models.MemberDTO{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}, nil
+}
+
+func (s *stubUsecase) CreatePullRequest(ctx context.Context, dto *models.InputCreatePullRequestDTO) (*models.OutputCreatePullRequestDTO, error) {
+	return nil, s.err
+}
+
+func (s *stubUsecase) MergePullRequest(ctx context.Context, dto *models.InputMergePullRequestDTO) (*models.OutputMergePullRequestDTO, error) {
+	return nil, s.err
+}
+
+func (s *stubUsecase) ClosePullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error) {
+	return nil, s.err
+}
+
+func newClient(t *testing.T, uc usecase.UsecaseInterface) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(rpc.UnaryInterceptor([]string{"admin"})))
+	rpc.Register(srv, uc)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func errorReason(t *testing.T, err error) string {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestTeamService_GetTeam(t *testing.T) {
+	uc := &stubUsecase{}
+	client := api.NewTeamServiceClient(newClient(t, uc))
+
+	t.Run("actor and admin come from metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), actor.Header, "admin")
+
+		team, err := client.GetTeam(ctx, &api.GetTeamRequest{TeamName: "backend"})
+		require.NoError(t, err)
+		assert.Equal(t, "backend", team.TeamName)
+		require.Len(t, team.Members, 1)
+		assert.Equal(t, "u1", team.Members[0].UserId)
+		assert.Equal(t, "admin", uc.actorId)
+		assert.True(t, uc.isAdmin)
+
+		ctx = metadata.AppendToOutgoingContext(context.Background(), actor.Header, "u1")
+		_, err = client.GetTeam(ctx, &api.GetTeamRequest{TeamName: "backend"})
+		require.NoError(t, err)
+		assert.Equal(t, "u1", uc.actorId)
+		assert.False(t, uc.isAdmin)
+	})
+
+	t.Run("empty team name", func(t *testing.T) {
+		_, err := client.GetTeam(context.Background(), &api.GetTeamRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, appErrors.HttpErrParseData.Code, errorReason(t, err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc.err = appErrors.ErrResourceNotFound
+		defer func() { uc.err = nil }()
+
+		_, err := client.GetTeam(context.Background(), &api.GetTeamRequest{TeamName: "backend"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, appErrors.HttpErrNotFound.Code, errorReason(t, err))
+	})
+}
+
+func TestPullRequestService_Errors(t *testing.T) {
+	uc := &stubUsecase{}
+	client := api.NewPullRequestServiceClient(newClient(t, uc))
+	ctx := context.Background()
+
+	t.Run("pull request exists", func(t *testing.T) {
+		uc.err = appErrors.ErrPullRequestExists
+
+		_, err := client.CreatePullRequest(ctx, &api.CreatePullRequestRequest{PullRequestId: "pr-1"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, appErrors.HttpErrPullRequestExists.Code, errorReason(t, err))
+	})
+
+	t.Run("merge policy violated", func(t *testing.T) {
+		uc.err = &appErrors.MergePolicyError{Violations: []appErrors.PolicyViolation{
+			{Rule: "required_approvals", Message: "1 of 2 approvals"},
+		}}
+
+		_, err := client.MergePullRequest(ctx, &api.MergePullRequestRequest{PullRequestId: "pr-1"})
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, appErrors.HttpErrMergePolicyViolated.Code, errorReason(t, err))
+
+		var failure *errdetails.PreconditionFailure
+		for _, d := range st.Details() {
+			if f, ok := d.(*errdetails.PreconditionFailure); ok {
+				failure = f
+			}
+		}
+		require.NotNil(t, failure)
+		require.Len(t, failure.Violations, 1)
+		assert.Equal(t, "required_approvals", failure.Violations[0].Type)
+		assert.Equal(t, "1 of 2 approvals", failure.Violations[0].Description)
+	})
+
+	t.Run("status transition", func(t *testing.T) {
+		uc.err = &appErrors.StatusTransitionError{From: models.StatusMerged, To: models.StatusClosed}
+
+		_, err := client.Close(ctx, &api.ChangeStatusRequest{PullRequestId: "pr-1"})
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, uc.err.Error(), st.Message())
+		assert.Equal(t, appErrors.HttpErrInvalidStatusTransition.Code, errorReason(t, err))
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		uc.err = appErrors.ErrServerError
+
+		_, err := client.CreatePullRequest(ctx, &api.CreatePullRequestRequest{PullRequestId: "pr-1"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, appErrors.HttpServerError.Code, errorReason(t, err))
+	})
+}
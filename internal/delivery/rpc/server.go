@@ -0,0 +1,497 @@
+package rpc
+
+import (
+	"PRmanager/api"
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase"
+	"PRmanager/pkg/actor"
+	appErrors "PRmanager/pkg/app_errors"
+	"PRmanager/pkg/logs"
+	"context"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Register serves the team, user and pull request services on s, backed by
+// the same usecase as the HTTP handlers. The servers return usecase errors
+// as is, s has to use UnaryInterceptor to turn them into statuses.
+func Register(s *grpc.Server, uc usecase.UsecaseInterface) {
+	api.RegisterTeamServiceServer(s, &TeamServer{usecase: uc})
+	api.RegisterUserServiceServer(s, &UserServer{usecase: uc})
+	api.RegisterPullRequestServiceServer(s, &PullRequestServer{usecase: uc})
+}
+
+// UnaryInterceptor does for gRPC calls what the HTTP middlewares do: it
+// collects the call logs and puts the actor from the x-actor-id metadata and
+// its admin flag into the context. Like the header, the metadata has to be set
+// by a trusted proxy.
+func UnaryInterceptor(admins []string) grpc.UnaryServerInterceptor {
+	allowed := make(map[string]bool, len(admins))
+	for _, a := range admins {
+		allowed[a] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, done := logs.StartContext(ctx, info.FullMethod)
+		defer done()
+
+		actorId := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(strings.ToLower(actor.Header)); len(values) > 0 {
+				actorId = values[0]
+			}
+		}
+		ctx = actor.WithActor(ctx, actorId)
+		ctx = actor.WithAdmin(ctx, actorId != "" && allowed[actorId])
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			logs.PrintLog(ctx, "[rpc] "+path.Base(info.FullMethod), err.Error())
+			return nil, toStatus(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// required rejects requests missing the id they are looked up by, as the
+// HTTP handlers do for empty query parameters.
+func required(value string) error {
+	if value == "" {
+		return appErrors.ErrParseData
+	}
+	return nil
+}
+
+type TeamServer struct {
+	api.UnimplementedTeamServiceServer
+	usecase usecase.UsecaseInterface
+}
+
+func (s *TeamServer) AddTeam(ctx context.Context, req *api.Team) (*api.Team, error) {
+	team := teamFromPb(req)
+	if err := s.usecase.AddTeam(ctx, team); err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) GetTeam(ctx context.Context, req *api.GetTeamRequest) (*api.Team, error) {
+	if err := required(req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	team, err := s.usecase.GetTeamByName(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetReviewerStrategy(ctx context.Context, req *api.SetReviewerStrategyRequest) (*api.Team, error) {
+	team, err := s.usecase.SetReviewerStrategy(ctx, &models.SetReviewerStrategyDTO{
+		TeamName:         req.GetTeamName(),
+		ReviewerStrategy: req.GetReviewerStrategy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetReviewersCount(ctx context.Context, req *api.SetReviewersCountRequest) (*api.Team, error) {
+	team, err := s.usecase.SetReviewersCount(ctx, &models.SetReviewersCountDTO{
+		TeamName:     req.GetTeamName(),
+		MinReviewers: int(req.GetMinReviewers()),
+		MaxReviewers: int(req.GetMaxReviewers()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetRequiredApprovals(ctx context.Context, req *api.SetRequiredApprovalsRequest) (*api.Team, error) {
+	team, err := s.usecase.SetRequiredApprovals(ctx, &models.SetRequiredApprovalsDTO{
+		TeamName:          req.GetTeamName(),
+		RequiredApprovals: int(req.GetRequiredApprovals()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetMergePolicy(ctx context.Context, req *api.SetMergePolicyRequest) (*api.Team, error) {
+	dto := &models.SetMergePolicyDTO{TeamName: req.GetTeamName()}
+	if policy := mergePolicyFromPb(req.GetMergePolicy()); policy != nil {
+		dto.MergePolicy = *policy
+	}
+
+	team, err := s.usecase.SetMergePolicy(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetReassignPool(ctx context.Context, req *api.SetReassignPoolRequest) (*api.Team, error) {
+	team, err := s.usecase.SetReassignPool(ctx, &models.SetReassignPoolDTO{
+		TeamName:     req.GetTeamName(),
+		ReassignPool: req.GetReassignPool(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetPairingLookback(ctx context.Context, req *api.SetPairingLookbackRequest) (*api.Team, error) {
+	team, err := s.usecase.SetPairingLookback(ctx, &models.SetPairingLookbackDTO{
+		TeamName:            req.GetTeamName(),
+		PairingLookbackDays: int(req.GetPairingLookbackDays()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) SetPartnerTeams(ctx context.Context, req *api.SetPartnerTeamsRequest) (*api.Team, error) {
+	team, err := s.usecase.SetPartnerTeams(ctx, &models.SetPartnerTeamsDTO{
+		TeamName:     req.GetTeamName(),
+		PartnerTeams: req.GetPartnerTeams(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamToPb(team), nil
+}
+
+func (s *TeamServer) UpdateTeamMembers(ctx context.Context, req *api.UpdateTeamMembersRequest) (*api.TeamMembersUpdated, error) {
+	updated, err := s.usecase.UpdateTeamMembers(ctx, &models.UpdateTeamMembersDTO{
+		TeamName:      req.GetTeamName(),
+		Members:       membersFromPb(req.GetMembers()),
+		ReviewsPolicy: req.GetReviewsPolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamMembersUpdatedToPb(updated), nil
+}
+
+func (s *TeamServer) RemoveTeamMembers(ctx context.Context, req *api.RemoveTeamMembersRequest) (*api.TeamMembersUpdated, error) {
+	updated, err := s.usecase.RemoveTeamMembers(ctx, &models.RemoveTeamMembersDTO{
+		TeamName:      req.GetTeamName(),
+		UserIds:       req.GetUserIds(),
+		ReviewsPolicy: req.GetReviewsPolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamMembersUpdatedToPb(updated), nil
+}
+
+func (s *TeamServer) MoveUser(ctx context.Context, req *api.MoveUserRequest) (*api.TeamMembersUpdated, error) {
+	updated, err := s.usecase.MoveUser(ctx, &models.MoveUserDTO{
+		UserId:        req.GetUserId(),
+		TeamName:      req.GetTeamName(),
+		ReviewsPolicy: req.GetReviewsPolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teamMembersUpdatedToPb(updated), nil
+}
+
+func (s *TeamServer) AddOwnershipRule(ctx context.Context, req *api.OwnershipRule) (*api.OwnershipRule, error) {
+	rule, err := s.usecase.AddOwnershipRule(ctx, ownershipRuleFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return ownershipRuleToPb(rule), nil
+}
+
+func (s *TeamServer) GetOwnershipRules(ctx context.Context, req *api.GetTeamRequest) (*api.OwnershipRules, error) {
+	if err := required(req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.usecase.GetOwnershipRules(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return ownershipRulesToPb(rules), nil
+}
+
+func (s *TeamServer) UpdateOwnershipRule(ctx context.Context, req *api.OwnershipRule) (*api.OwnershipRule, error) {
+	rule, err := s.usecase.UpdateOwnershipRule(ctx, ownershipRuleFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return ownershipRuleToPb(rule), nil
+}
+
+func (s *TeamServer) DeleteOwnershipRule(ctx context.Context, req *api.DeleteOwnershipRuleRequest) (*emptypb.Empty, error) {
+	err := s.usecase.DeleteOwnershipRule(ctx, &models.DeleteOwnershipRuleDTO{RuleId: int(req.GetRuleId())})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+type UserServer struct {
+	api.UnimplementedUserServiceServer
+	usecase usecase.UsecaseInterface
+}
+
+func (s *UserServer) SetIsActive(ctx context.Context, req *api.SetIsActiveRequest) (*api.User, error) {
+	user, err := s.usecase.SetIsActive(ctx, &models.SetIsActiveDTO{
+		UserID:   req.GetUserId(),
+		IsActive: req.GetIsActive(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userToPb(user), nil
+}
+
+func (s *UserServer) SetTags(ctx context.Context, req *api.UserTagsRequest) (*api.User, error) {
+	user, err := s.usecase.SetUserTags(ctx, &models.UserTagsDTO{UserId: req.GetUserId(), Tags: req.GetTags()})
+	if err != nil {
+		return nil, err
+	}
+	return userToPb(user), nil
+}
+
+func (s *UserServer) AddTags(ctx context.Context, req *api.UserTagsRequest) (*api.User, error) {
+	user, err := s.usecase.AddUserTags(ctx, &models.UserTagsDTO{UserId: req.GetUserId(), Tags: req.GetTags()})
+	if err != nil {
+		return nil, err
+	}
+	return userToPb(user), nil
+}
+
+func (s *UserServer) RemoveTags(ctx context.Context, req *api.UserTagsRequest) (*api.User, error) {
+	user, err := s.usecase.RemoveUserTags(ctx, &models.UserTagsDTO{UserId: req.GetUserId(), Tags: req.GetTags()})
+	if err != nil {
+		return nil, err
+	}
+	return userToPb(user), nil
+}
+
+func (s *UserServer) SetMaxOpenReviews(ctx context.Context, req *api.SetMaxOpenReviewsRequest) (*api.User, error) {
+	user, err := s.usecase.SetMaxOpenReviews(ctx, &models.SetMaxOpenReviewsDTO{
+		UserId:         req.GetUserId(),
+		MaxOpenReviews: toIntPtr(req.MaxOpenReviews),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userToPb(user), nil
+}
+
+func (s *UserServer) SetContacts(ctx context.Context, req *api.UserContacts) (*api.UserContacts, error) {
+	contacts, err := s.usecase.SetContacts(ctx, &models.UserContactsDTO{
+		UserId:          req.GetUserId(),
+		SlackWebhookUrl: req.GetSlackWebhookUrl(),
+		Email:           req.GetEmail(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contactsToPb(contacts), nil
+}
+
+func (s *UserServer) GetContacts(ctx context.Context, req *api.GetUserRequest) (*api.UserContacts, error) {
+	if err := required(req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.usecase.GetContacts(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return contactsToPb(contacts), nil
+}
+
+func (s *UserServer) AddUnavailability(ctx context.Context, req *api.Unavailability) (*api.Unavailability, error) {
+	period, err := s.usecase.AddUnavailability(ctx, unavailabilityFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return unavailabilityToPb(period), nil
+}
+
+func (s *UserServer) GetUnavailability(ctx context.Context, req *api.GetUserRequest) (*api.UserUnavailability, error) {
+	if err := required(req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	periods, err := s.usecase.GetUnavailability(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return userUnavailabilityToPb(periods), nil
+}
+
+func (s *UserServer) RemoveUnavailability(ctx context.Context, req *api.RemoveUnavailabilityRequest) (*emptypb.Empty, error) {
+	err := s.usecase.RemoveUnavailability(ctx, &models.RemoveUnavailabilityDTO{PeriodId: int(req.GetPeriodId())})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserServer) BulkDeactivate(ctx context.Context, req *api.BulkDeactivateRequest) (*api.BulkDeactivateResult, error) {
+	result, err := s.usecase.BulkDeactivate(ctx, &models.BulkDeactivateDTO{
+		UserIds:  req.GetUserIds(),
+		TeamName: req.GetTeamName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bulkDeactivateResultToPb(result), nil
+}
+
+func (s *UserServer) GetReview(ctx context.Context, req *api.GetUserRequest) (*api.Review, error) {
+	if err := required(req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	review, err := s.usecase.GetReview(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return reviewToPb(review), nil
+}
+
+type PullRequestServer struct {
+	api.UnimplementedPullRequestServiceServer
+	usecase usecase.UsecaseInterface
+}
+
+func (s *PullRequestServer) CreatePullRequest(ctx context.Context, req *api.CreatePullRequestRequest) (*api.CreatedPullRequest, error) {
+	pr, err := s.usecase.CreatePullRequest(ctx, &models.InputCreatePullRequestDTO{
+		PullRequestId:   req.GetPullRequestId(),
+		PullRequestName: req.GetPullRequestName(),
+		AuthorId:        req.GetAuthorId(),
+		ReviewersCount:  toIntPtr(req.ReviewersCount),
+		Draft:           req.GetDraft(),
+		ChangedFiles:    req.GetChangedFiles(),
+		RequiredTags:    req.GetRequiredTags(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdPullRequestToPb(pr), nil
+}
+
+func (s *PullRequestServer) MergePullRequest(ctx context.Context, req *api.MergePullRequestRequest) (*api.MergedPullRequest, error) {
+	pr, err := s.usecase.MergePullRequest(ctx, &models.InputMergePullRequestDTO{
+		PullRequestId: req.GetPullRequestId(),
+		Force:         req.GetForce(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedPullRequestToPb(pr), nil
+}
+
+func (s *PullRequestServer) MarkReady(ctx context.Context, req *api.ChangeStatusRequest) (*api.PullRequestStatus, error) {
+	pr, err := s.usecase.MarkReadyPullRequest(ctx, &models.InputChangeStatusPullRequestDTO{PullRequestId: req.GetPullRequestId()})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestStatusToPb(pr), nil
+}
+
+func (s *PullRequestServer) Close(ctx context.Context, req *api.ChangeStatusRequest) (*api.PullRequestStatus, error) {
+	pr, err := s.usecase.ClosePullRequest(ctx, &models.InputChangeStatusPullRequestDTO{PullRequestId: req.GetPullRequestId()})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestStatusToPb(pr), nil
+}
+
+func (s *PullRequestServer) Reopen(ctx context.Context, req *api.ChangeStatusRequest) (*api.PullRequestStatus, error) {
+	pr, err := s.usecase.ReopenPullRequest(ctx, &models.InputChangeStatusPullRequestDTO{PullRequestId: req.GetPullRequestId()})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestStatusToPb(pr), nil
+}
+
+func (s *PullRequestServer) Reassign(ctx context.Context, req *api.ReassignRequest) (*api.ReassignedPullRequest, error) {
+	pr, err := s.usecase.Reassign(ctx, &models.InputReassignDTO{
+		PullRequestId:       req.GetPullRequestId(),
+		UserId:              req.GetOldReviewerId(),
+		RequestedReviewerId: req.GetRequestedReviewerId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reassignedPullRequestToPb(pr), nil
+}
+
+func (s *PullRequestServer) AddReviewers(ctx context.Context, req *api.ChangeReviewersRequest) (*api.PullRequestReviewers, error) {
+	pr, err := s.usecase.AddReviewers(ctx, &models.InputChangeReviewersDTO{
+		PullRequestId: req.GetPullRequestId(),
+		ReviewerIds:   req.GetReviewerIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestReviewersToPb(pr), nil
+}
+
+func (s *PullRequestServer) RemoveReviewers(ctx context.Context, req *api.ChangeReviewersRequest) (*api.PullRequestReviewers, error) {
+	pr, err := s.usecase.RemoveReviewers(ctx, &models.InputChangeReviewersDTO{
+		PullRequestId: req.GetPullRequestId(),
+		ReviewerIds:   req.GetReviewerIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestReviewersToPb(pr), nil
+}
+
+func (s *PullRequestServer) SubmitReview(ctx context.Context, req *api.SubmitReviewRequest) (*api.SubmittedReview, error) {
+	review, err := s.usecase.SubmitReview(ctx, &models.InputSubmitReviewDTO{
+		PullRequestId: req.GetPullRequestId(),
+		ReviewerId:    req.GetReviewerId(),
+		Decision:      req.GetDecision(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submittedReviewToPb(review), nil
+}
+
+func (s *PullRequestServer) GetHistory(ctx context.Context, req *api.GetPullRequestRequest) (*api.PullRequestHistory, error) {
+	if err := required(req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	history, err := s.usecase.GetPullRequestHistory(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return historyToPb(history), nil
+}
+
+func (s *PullRequestServer) GetAssignmentExplanation(ctx context.Context, req *api.GetPullRequestRequest) (*api.AssignmentExplanations, error) {
+	if err := required(req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	explanations, err := s.usecase.GetAssignmentExplanation(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return explanationsToPb(explanations), nil
+}
@@ -1,14 +1,118 @@
 package models
 
+import "encoding/json"
+
 type TeamDTO struct {
-	TeamName string      `json:"team_name"`
-	Members  []MemberDTO `json:"members"`
+	TeamName            string          `json:"team_name"`
+	ReviewerStrategy    string          `json:"reviewer_strategy,omitempty"`
+	MinReviewers        *int            `json:"min_reviewers,omitempty"`
+	MaxReviewers        *int            `json:"max_reviewers,omitempty"`
+	RequiredApprovals   *int            `json:"required_approvals,omitempty"`
+	MergePolicy         *MergePolicyDTO `json:"merge_policy,omitempty"`
+	ReassignPool        string          `json:"reassign_pool,omitempty"`
+	PairingLookbackDays *int            `json:"pairing_lookback_days,omitempty"`
+	PartnerTeams        []string        `json:"partner_teams,omitempty"`
+	Members             []MemberDTO     `json:"members"`
+}
+
+type MergePolicyDTO struct {
+	RequireReviewer    bool `json:"require_reviewer"`
+	NoChangesRequested bool `json:"no_changes_requested"`
+	NoSelfApproval     bool `json:"no_self_approval"`
 }
 
 type MemberDTO struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	UserID       string   `json:"user_id"`
+	Username     string   `json:"username"`
+	IsActive     bool     `json:"is_active"`
+	ReviewWeight int      `json:"review_weight,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	// MaxOpenReviews is the capacity of the member, OpenReviews its current
+	// load; the latter is only filled in responses.
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+	OpenReviews    *int `json:"open_reviews,omitempty"`
+}
+
+type SetReviewerStrategyDTO struct {
+	TeamName         string `json:"team_name"`
+	ReviewerStrategy string `json:"reviewer_strategy"`
+}
+
+type SetReviewersCountDTO struct {
+	TeamName     string `json:"team_name"`
+	MinReviewers int    `json:"min_reviewers"`
+	MaxReviewers int    `json:"max_reviewers"`
+}
+
+type SetMergePolicyDTO struct {
+	TeamName    string         `json:"team_name"`
+	MergePolicy MergePolicyDTO `json:"merge_policy"`
+}
+
+type SetReassignPoolDTO struct {
+	TeamName     string `json:"team_name"`
+	ReassignPool string `json:"reassign_pool"`
+}
+
+type SetPairingLookbackDTO struct {
+	TeamName            string `json:"team_name"`
+	PairingLookbackDays int    `json:"pairing_lookback_days"`
+}
+
+type SetPartnerTeamsDTO struct {
+	TeamName     string   `json:"team_name"`
+	PartnerTeams []string `json:"partner_teams"`
+}
+
+type SetRequiredApprovalsDTO struct {
+	TeamName          string `json:"team_name"`
+	RequiredApprovals int    `json:"required_approvals"`
+}
+
+const (
+	ReviewsPolicyKeep     = "keep"
+	ReviewsPolicyReassign = "reassign"
+)
+
+type UpdateTeamMembersDTO struct {
+	TeamName      string      `json:"team_name"`
+	Members       []MemberDTO `json:"members"`
+	ReviewsPolicy string      `json:"reviews_policy,omitempty"`
+}
+
+type RemoveTeamMembersDTO struct {
+	TeamName      string   `json:"team_name"`
+	UserIds       []string `json:"user_ids"`
+	ReviewsPolicy string   `json:"reviews_policy,omitempty"`
+}
+
+type MoveUserDTO struct {
+	UserId        string `json:"user_id"`
+	TeamName      string `json:"team_name"`
+	ReviewsPolicy string `json:"reviews_policy,omitempty"`
+}
+
+type ReviewerChangeDTO struct {
+	PullRequestId string `json:"pull_request_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	ReplacedBy    string `json:"replaced_by"`
+}
+
+type TeamMembersUpdatedDTO struct {
+	Team       TeamDTO             `json:"team"`
+	Reassigned []ReviewerChangeDTO `json:"reassigned"`
+}
+
+type BulkDeactivateDTO struct {
+	UserIds  []string `json:"user_ids"`
+	TeamName string   `json:"team_name"`
+}
+
+type BulkDeactivateResultDTO struct {
+	DeactivatedUsers []string            `json:"deactivated_users"`
+	Reassigned       []ReviewerChangeDTO `json:"reassigned"`
+	Dropped          []ReviewerChangeDTO `json:"dropped"`
+	WithoutReviewers []string            `json:"without_reviewers"`
 }
 
 type SetIsActiveDTO struct {
@@ -17,10 +121,45 @@ type SetIsActiveDTO struct {
 }
 
 type UserDTO struct {
+	UserId         string   `json:"user_id"`
+	UserName       string   `json:"user_name"`
+	TeamName       string   `json:"team_name"`
+	IsActive       bool     `json:"is_active"`
+	Tags           []string `json:"tags"`
+	MaxOpenReviews *int     `json:"max_open_reviews,omitempty"`
+}
+
+type SetMaxOpenReviewsDTO struct {
+	UserId         string `json:"user_id"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+type UnavailabilityDTO struct {
+	PeriodId int    `json:"period_id"`
 	UserId   string `json:"user_id"`
-	UserName string `json:"user_name"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	StartsAt string `json:"starts_at"`
+	EndsAt   string `json:"ends_at"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type UserUnavailabilityDTO struct {
+	UserId  string              `json:"user_id"`
+	Periods []UnavailabilityDTO `json:"periods"`
+}
+
+type RemoveUnavailabilityDTO struct {
+	PeriodId int `json:"period_id"`
+}
+
+type UserContactsDTO struct {
+	UserId          string `json:"user_id"`
+	SlackWebhookUrl string `json:"slack_webhook_url"`
+	Email           string `json:"email"`
+}
+
+type UserTagsDTO struct {
+	UserId string   `json:"user_id"`
+	Tags   []string `json:"tags"`
 }
 
 type ReviewDTO struct {
@@ -33,45 +172,283 @@ type PullRequestShortDTO struct {
 	PullRequestName string `json:"pull_request_name"`
 	AuthorId        string `json:"author_id"`
 	Status          string `json:"status"`
+	Decision        string `json:"decision"`
+	DecidedAt       string `json:"decided_at,omitempty"`
 }
 
 type InputCreatePullRequestDTO struct {
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorId        string `json:"author_id"`
+	PullRequestId   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorId        string   `json:"author_id"`
+	ReviewersCount  *int     `json:"reviewers_count,omitempty"`
+	Draft           bool     `json:"draft,omitempty"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	RequiredTags    []string `json:"required_tags,omitempty"`
 }
 
 type OutputCreatePullRequestDTO struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
+	PullRequestID      string            `json:"pull_request_id"`
+	PullRequestName    string            `json:"pull_request_name"`
+	AuthorID           string            `json:"author_id"`
+	Status             string            `json:"status"`
+	AssignedReviewers  []string          `json:"assigned_reviewers"`
+	ReviewerPools      []ReviewerPoolDTO `json:"reviewer_pools"`
+	RequiredReviewers  int               `json:"required_reviewers"`
+	NotEnoughReviewers bool              `json:"not_enough_reviewers"`
+	CapacityLimited    bool              `json:"capacity_limited"`
+}
+
+type ReviewerPoolDTO struct {
+	ReviewerId  string `json:"reviewer_id"`
+	Pool        string `json:"pool"`
+	TeamName    string `json:"team_name,omitempty"`
+	MatchedRule string `json:"matched_rule,omitempty"`
+}
+
+type OwnershipRuleDTO struct {
+	RuleId   int      `json:"rule_id"`
+	TeamName string   `json:"team_name"`
+	Pattern  string   `json:"pattern"`
+	Owners   []string `json:"owners"`
+}
+
+type OwnershipRulesDTO struct {
+	TeamName string             `json:"team_name"`
+	Rules    []OwnershipRuleDTO `json:"rules"`
+}
+
+type DeleteOwnershipRuleDTO struct {
+	RuleId int `json:"rule_id"`
+}
+
+type PullRequestEventDTO struct {
+	EventType     string `json:"event_type"`
+	Actor         string `json:"actor,omitempty"`
+	OldReviewerId string `json:"old_reviewer_id,omitempty"`
+	NewReviewerId string `json:"new_reviewer_id,omitempty"`
+	Reason        string `json:"reason"`
+	Seed          *int64 `json:"selection_seed,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type PullRequestHistoryDTO struct {
+	PullRequestId string                `json:"pull_request_id"`
+	Events        []PullRequestEventDTO `json:"events"`
+}
+
+type AssignmentCandidateDTO struct {
+	UserId   string `json:"user_id"`
+	Selected bool   `json:"selected"`
+	Pool     string `json:"pool,omitempty"`
+}
+
+type ExcludedCandidateDTO struct {
+	UserId string `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+type AssignmentExplanationDTO struct {
+	Trigger            string                   `json:"trigger"`
+	Actor              string                   `json:"actor,omitempty"`
+	Strategy           string                   `json:"strategy"`
+	Seed               *int64                   `json:"selection_seed,omitempty"`
+	ReplacedReviewerId string                   `json:"replaced_reviewer_id,omitempty"`
+	SelectedReviewers  []string                 `json:"selected_reviewers"`
+	Candidates         []AssignmentCandidateDTO `json:"candidates"`
+	Excluded           []ExcludedCandidateDTO   `json:"excluded"`
+	CreatedAt          string                   `json:"created_at"`
+}
+
+type AssignmentExplanationsDTO struct {
+	PullRequestId string                     `json:"pull_request_id"`
+	Assignments   []AssignmentExplanationDTO `json:"assignments"`
+}
+
+type WebhookDTO struct {
+	SubscriptionId int      `json:"subscription_id"`
+	Url            string   `json:"url"`
+	Secret         string   `json:"secret,omitempty"`
+	EventTypes     []string `json:"event_types"`
+	CreatedAt      string   `json:"created_at,omitempty"`
+}
+
+type WebhooksDTO struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+}
+
+type RemoveWebhookDTO struct {
+	SubscriptionId int `json:"subscription_id"`
+}
+
+type WebhookDeliveryDTO struct {
+	DeliveryId     int             `json:"delivery_id"`
+	SubscriptionId int             `json:"subscription_id"`
+	Url            string          `json:"url"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"last_error"`
+	CreatedAt      string          `json:"created_at"`
+	DeadAt         string          `json:"dead_at"`
+}
+
+type WebhookDeadLettersDTO struct {
+	Deliveries []WebhookDeliveryDTO `json:"deliveries"`
+}
+
+// Results of an inbound webhook.
+const (
+	InboundProcessed = "processed"
+	InboundDuplicate = "duplicate"
+	InboundIgnored   = "ignored"
+)
+
+type InboundWebhookResultDTO struct {
+	DeliveryId    string `json:"delivery_id"`
+	Action        string `json:"action"`
+	PullRequestId string `json:"pull_request_id,omitempty"`
+	Result        string `json:"result"`
 }
 
 type InputMergePullRequestDTO struct {
 	PullRequestId string `json:"pull_request_id"`
+	Force         bool   `json:"force,omitempty"`
 }
 
 type OutputMergePullRequestDTO struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	MergedAt          string   `json:"merged_at"`
+	PullRequestID      string                `json:"pull_request_id"`
+	PullRequestName    string                `json:"pull_request_name"`
+	AuthorID           string                `json:"author_id"`
+	Status             string                `json:"status"`
+	AssignedReviewers  []string              `json:"assigned_reviewers"`
+	MergedAt           string                `json:"merged_at"`
+	RequiredReviewers  int                   `json:"required_reviewers"`
+	NotEnoughReviewers bool                  `json:"not_enough_reviewers"`
+	Reviews            []ReviewerDecisionDTO `json:"reviews"`
+	Approvals          int                   `json:"approvals"`
+	RequiredApprovals  int                   `json:"required_approvals"`
+	Forced             bool                  `json:"forced,omitempty"`
+	BypassedRules      []string              `json:"bypassed_rules,omitempty"`
 }
 
-type InputReassignDTO struct {
+type ReviewerDecisionDTO struct {
+	ReviewerId string `json:"reviewer_id"`
+	Decision   string `json:"decision"`
+	DecidedAt  string `json:"decided_at,omitempty"`
+}
+
+type InputSubmitReviewDTO struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+	Decision      string `json:"decision"`
+}
+
+type OutputSubmitReviewDTO struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+	Decision      string `json:"decision"`
+	DecidedAt     string `json:"decided_at"`
+}
+
+type InputChangeStatusPullRequestDTO struct {
 	PullRequestId string `json:"pull_request_id"`
-	UserId        string `json:"old_reviewer_id"`
+}
+
+type OutputChangeStatusPullRequestDTO struct {
+	PullRequestID      string            `json:"pull_request_id"`
+	PullRequestName    string            `json:"pull_request_name"`
+	AuthorID           string            `json:"author_id"`
+	Status             string            `json:"status"`
+	AssignedReviewers  []string          `json:"assigned_reviewers"`
+	ReviewerPools      []ReviewerPoolDTO `json:"reviewer_pools,omitempty"`
+	RequiredReviewers  int               `json:"required_reviewers"`
+	NotEnoughReviewers bool              `json:"not_enough_reviewers"`
+	CapacityLimited    bool              `json:"capacity_limited,omitempty"`
+}
+
+type InputReassignDTO struct {
+	PullRequestId       string `json:"pull_request_id"`
+	UserId              string `json:"old_reviewer_id"`
+	RequestedReviewerId string `json:"requested_reviewer_id,omitempty"`
+}
+
+type InputChangeReviewersDTO struct {
+	PullRequestId string   `json:"pull_request_id"`
+	ReviewerIds   []string `json:"reviewer_ids"`
+}
+
+type OutputChangeReviewersDTO struct {
+	PullRequestID      string   `json:"pull_request_id"`
+	PullRequestName    string   `json:"pull_request_name"`
+	AuthorID           string   `json:"author_id"`
+	Status             string   `json:"status"`
+	AssignedReviewers  []string `json:"assigned_reviewers"`
+	RequiredReviewers  int      `json:"required_reviewers"`
+	NotEnoughReviewers bool     `json:"not_enough_reviewers"`
 }
 
 type OutputReassignDTO struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	ReplacedBy        string   `json:"replaced_by"`
+	PullRequestID      string   `json:"pull_request_id"`
+	PullRequestName    string   `json:"pull_request_name"`
+	AuthorID           string   `json:"author_id"`
+	Status             string   `json:"status"`
+	AssignedReviewers  []string `json:"assigned_reviewers"`
+	ReplacedBy         string   `json:"replaced_by"`
+	RequiredReviewers  int      `json:"required_reviewers"`
+	NotEnoughReviewers bool     `json:"not_enough_reviewers"`
+	CapacityLimited    bool     `json:"capacity_limited,omitempty"`
+}
+
+type InputAssignmentStatsDTO struct {
+	From     string
+	To       string
+	TeamName string
+}
+
+type UserAssignmentStatsDTO struct {
+	UserId   string `json:"user_id"`
+	UserName string `json:"user_name"`
+	TeamName string `json:"team_name"`
+	Assigned int    `json:"assigned"`
+	Open     int    `json:"open"`
+	Merged   int    `json:"merged"`
+}
+
+type TeamAssignmentStatsDTO struct {
+	TeamName string `json:"team_name"`
+	Members  int    `json:"members"`
+	Assigned int    `json:"assigned"`
+	Open     int    `json:"open"`
+	Merged   int    `json:"merged"`
+}
+
+type AssignmentStatsDTO struct {
+	From  string                   `json:"from,omitempty"`
+	To    string                   `json:"to,omitempty"`
+	Users []UserAssignmentStatsDTO `json:"users"`
+	Teams []TeamAssignmentStatsDTO `json:"teams"`
+}
+
+// LiveEventDTO is an event of the live stream. TeamName is the team whose
+// reviews changed; for user.activity it is the team of the user.
+type LiveEventDTO struct {
+	EventId       int64    `json:"event_id"`
+	Type          string   `json:"type"`
+	TeamName      string   `json:"team_name,omitempty"`
+	PullRequestId string   `json:"pull_request_id,omitempty"`
+	AuthorId      string   `json:"author_id,omitempty"`
+	Reviewers     []string `json:"reviewers,omitempty"`
+	OldReviewerId string   `json:"old_reviewer_id,omitempty"`
+	NewReviewerId string   `json:"new_reviewer_id,omitempty"`
+	UserId        string   `json:"user_id,omitempty"`
+	IsActive      *bool    `json:"is_active,omitempty"`
+	OccurredAt    string   `json:"occurred_at"`
+}
+
+// LiveEventFilterDTO narrows the live stream to a team or a user. Events
+// after LastEventId are replayed first.
+type LiveEventFilterDTO struct {
+	TeamName    string
+	UserId      string
+	LastEventId int64
 }
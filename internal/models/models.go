@@ -6,28 +6,330 @@ import (
 )
 
 type Team struct {
-	TeamId      int
-	TeamName    string
-	TeamMembers []*User
+	TeamId            int
+	TeamName          string
+	ReviewerStrategy  string
+	MinReviewers      int
+	MaxReviewers      int
+	RequiredApprovals int
+	MergePolicy       MergePolicy
+	ReassignPool      string
+	// PairingLookbackDays is how far back the least_paired strategy looks
+	// for reviews between an author and a candidate.
+	PairingLookbackDays int
+	PartnerTeams        []string
+	TeamMembers         []*User
 }
 
+// MergePolicy switches on the merge checks of a team on top of the required
+// approvals count. NoSelfApproval forbids the only approver of a PR to merge
+// it.
+type MergePolicy struct {
+	RequireReviewer    bool
+	NoChangesRequested bool
+	NoSelfApproval     bool
+}
+
+// Reviewer pools: owners of the changed files, members of the author's team or
+// of one of its partner teams.
+const (
+	PoolOwner   = "owner"
+	PoolHome    = "home"
+	PoolPartner = "partner"
+)
+
+// Reassign pools: where the replacement of a reassigned reviewer is picked from.
+const (
+	ReassignPoolAuthorTeam   = "author_team"
+	ReassignPoolReviewerTeam = "reviewer_team"
+	ReassignPoolUnion        = "union"
+)
+
+const (
+	RuleRequiredApprovals  = "required_approvals"
+	RuleRequireReviewer    = "require_reviewer"
+	RuleNoChangesRequested = "no_changes_requested"
+	RuleNoSelfApproval     = "no_self_approval"
+)
+
 type User struct {
-	UserId   int
-	SystemId string
-	UserName string
-	TeamId   int
-	TeamName string
-	IsActive bool
+	UserId       int
+	SystemId     string
+	UserName     string
+	TeamId       int
+	TeamName     string
+	IsActive     bool
+	ReviewWeight int
+	Tags         []string
+	// Unavailable is set when the user is inside an unavailability period.
+	Unavailable bool
+	// MaxOpenReviews limits open reviews the user holds at once, nil means
+	// no limit. OpenReviews is the current number of them.
+	MaxOpenReviews *int
+	OpenReviews    int
+}
+
+// Pairing sums up the reviews a user made on pull requests of one author.
+type Pairing struct {
+	Reviews int
+	LastAt  time.Time
+}
+
+// Unavailability is a period, e.g. a vacation, when a user takes no reviews.
+type Unavailability struct {
+	PeriodId int
+	User     *User
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
 }
 
 type PullRequest struct {
-	PullRequestId     int
-	SystemId          string
-	PullRequestName   string
-	AuthorId          int
-	AuthorSystemId    string
+	PullRequestId   int
+	SystemId        string
+	PullRequestName string
+	AuthorId        int
+	AuthorSystemId  string
+	// AuthorTeamId is the team of the author when the PR was opened.
+	AuthorTeamId      int
 	Status            string
 	AssigneeReviewers []*User
 	CreatedAt         time.Time
 	MergedAt          sql.NullTime
+	ChangedFiles      []string
+	RequiredTags      []string
+	Decisions         map[int]*ReviewDecision
+}
+
+// OwnershipRule is a CODEOWNERS-style line of a team: paths matching Pattern
+// are owned by Owners. A rule without owners leaves its paths unowned.
+type OwnershipRule struct {
+	RuleId  int
+	TeamId  int
+	Pattern string
+	Owners  []*User
 }
+
+const (
+	DecisionPending          = "PENDING"
+	DecisionApproved         = "APPROVED"
+	DecisionChangesRequested = "CHANGES_REQUESTED"
+	DecisionCommented        = "COMMENTED"
+)
+
+// ReviewDecision is the latest decision of a reviewer. Decisions of a PR are
+// keyed by reviewer user id; reviewers without a decision have no entry.
+type ReviewDecision struct {
+	Decision  string
+	DecidedAt time.Time
+}
+
+// ReviewerChange describes a review slot taken from OldReviewer; NewReviewer
+// is nil when nobody could take the slot and it is dropped. ReviewersLeft is
+// the number of reviewers the PR has once the change is applied.
+type ReviewerChange struct {
+	PullRequestId       int
+	PullRequestSystemId string
+	AuthorSystemId      string
+	// TeamName is the team of the PR author.
+	TeamName      string
+	OldReviewer   *User
+	NewReviewer   *User
+	ReviewersLeft int
+	// Seed is the seed of the selection that picked NewReviewer.
+	Seed *int64
+}
+
+const (
+	StatusDraft  = "DRAFT"
+	StatusOpen   = "OPEN"
+	StatusMerged = "MERGED"
+	StatusClosed = "CLOSED"
+)
+
+const (
+	EventPullRequestCreated  = "PR_CREATED"
+	EventPullRequestReady    = "PR_READY"
+	EventPullRequestMerged   = "PR_MERGED"
+	EventPullRequestClosed   = "PR_CLOSED"
+	EventPullRequestReopened = "PR_REOPENED"
+	EventReviewerAssigned    = "REVIEWER_ASSIGNED"
+	EventReviewerReplaced    = "REVIEWER_REPLACED"
+	EventReviewerRemoved     = "REVIEWER_REMOVED"
+	EventReviewSubmitted     = "REVIEW_SUBMITTED"
+)
+
+// PrEvent is a record of the pull request history. Reviewer ids are 0 when
+// the event has no such reviewer.
+type PrEvent struct {
+	EventId             int
+	PullRequestId       int
+	EventType           string
+	Actor               string
+	OldReviewerId       int
+	OldReviewerSystemId string
+	NewReviewerId       int
+	NewReviewerSystemId string
+	Reason              string
+	// Seed replays the random selection of NewReviewer, nil when the
+	// reviewer was not picked at random.
+	Seed      *int64
+	CreatedAt time.Time
+}
+
+// Webhook event types a subscription can ask for.
+const (
+	WebhookPullRequestCreated  = "pr.created"
+	WebhookPullRequestReady    = "pr.ready"
+	WebhookPullRequestMerged   = "pr.merged"
+	WebhookPullRequestClosed   = "pr.closed"
+	WebhookPullRequestReopened = "pr.reopened"
+	WebhookReviewerAssigned    = "reviewer.assigned"
+	WebhookReviewerReplaced    = "reviewer.replaced"
+	WebhookReviewerRemoved     = "reviewer.removed"
+	WebhookReviewSubmitted     = "review.submitted"
+)
+
+// WebhookEventTypes maps history events to the webhook event sent for them.
+var WebhookEventTypes = map[string]string{
+	EventPullRequestCreated:  WebhookPullRequestCreated,
+	EventPullRequestReady:    WebhookPullRequestReady,
+	EventPullRequestMerged:   WebhookPullRequestMerged,
+	EventPullRequestClosed:   WebhookPullRequestClosed,
+	EventPullRequestReopened: WebhookPullRequestReopened,
+	EventReviewerAssigned:    WebhookReviewerAssigned,
+	EventReviewerReplaced:    WebhookReviewerReplaced,
+	EventReviewerRemoved:     WebhookReviewerRemoved,
+	EventReviewSubmitted:     WebhookReviewSubmitted,
+}
+
+type WebhookSubscription struct {
+	SubscriptionId int
+	Url            string
+	Secret         string
+	EventTypes     []string
+	CreatedAt      time.Time
+}
+
+// WebhookDelivery is an outbox record of one event for one subscription.
+// Attempts counts tries including the one in progress; DeadAt is set once
+// the delivery is given up.
+type WebhookDelivery struct {
+	DeliveryId     int
+	SubscriptionId int
+	Url            string
+	Secret         string
+	EventType      string
+	Payload        []byte
+	Attempts       int
+	LastError      string
+	CreatedAt      time.Time
+	DeadAt         sql.NullTime
+}
+
+// Code hosts sending pull request webhooks to the service.
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+)
+
+// Actions of inbound pull request webhooks the service acts on.
+const (
+	InboundOpened          = "opened"
+	InboundReady           = "ready_for_review"
+	InboundMerged          = "merged"
+	InboundClosed          = "closed"
+	InboundReopened        = "reopened"
+	InboundReviewRequested = "review_requested"
+)
+
+// InboundPullRequestEvent is a pull request webhook of a code host reduced
+// to what the service needs. Logins are user system ids; Action keeps the
+// raw action of the host when it is none of the Inbound ones.
+type InboundPullRequestEvent struct {
+	Provider           string
+	DeliveryId         string
+	Action             string
+	PullRequestId      string
+	Title              string
+	AuthorLogin        string
+	Draft              bool
+	RequestedReviewers []string
+	// Ready is set on a review request that also took the PR out of draft.
+	Ready bool
+}
+
+// What an assignment explanation was recorded for.
+const (
+	AssignmentCreated    = "created"
+	AssignmentReady      = "ready"
+	AssignmentReassigned = "reassigned"
+)
+
+// StrategyRequested is the strategy of an explanation where the reviewer was
+// named by the caller instead of being selected.
+const StrategyRequested = "requested"
+
+// Reasons a user was left out of a reviewer selection.
+const (
+	ExcludedAuthor          = "author"
+	ExcludedInactive        = "inactive"
+	ExcludedUnavailable     = "unavailable"
+	ExcludedAlreadyAssigned = "already_assigned"
+	ExcludedAtCapacity      = "at_capacity"
+)
+
+// AssignmentExplanation tells how reviewers of a pull request were picked:
+// every user the selection looked at, why some of them were left out and who
+// was selected. ReplacedReviewerId is 0 unless a reviewer was reassigned.
+type AssignmentExplanation struct {
+	ExplanationId            int
+	PullRequestId            int
+	Trigger                  string
+	Actor                    string
+	Strategy                 string
+	Seed                     *int64
+	ReplacedReviewerId       int
+	ReplacedReviewerSystemId string
+	Candidates               []*AssignmentCandidate
+	CreatedAt                time.Time
+}
+
+// AssignmentCandidate is a user considered by a selection. ExcludedReason is
+// empty when the user could be picked; Pool is set for selected users picked
+// from a reviewer pool.
+type AssignmentCandidate struct {
+	UserId         int
+	SystemId       string
+	ExcludedReason string
+	Selected       bool
+	Pool           string
+}
+
+type ReviewerStats struct {
+	UserSystemId string
+	UserName     string
+	TeamName     string
+	Assigned     int
+	Open         int
+	Merged       int
+}
+
+// Contact is where a user gets review notifications: a Slack-compatible
+// incoming webhook and an email address. An empty value turns the channel off.
+type Contact struct {
+	UserId          int
+	SystemId        string
+	UserName        string
+	SlackWebhookUrl string
+	Email           string
+}
+
+// Types of live events streamed to dashboards.
+const (
+	LiveReviewerAssigned  = "reviewer.assigned"
+	LiveReviewerReplaced  = "reviewer.replaced"
+	LiveReviewerRemoved   = "reviewer.removed"
+	LivePullRequestMerged = "pr.merged"
+	LiveUserActivity      = "user.activity"
+)
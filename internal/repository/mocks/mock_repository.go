@@ -9,6 +9,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,18 +37,75 @@ func (m *MockRepositoryInterface) EXPECT() *MockRepositoryInterfaceMockRecorder
 	return m.recorder
 }
 
+// AddAssignmentExplanation mocks base method.
+func (m *MockRepositoryInterface) AddAssignmentExplanation(ctx context.Context, explanation *models.AssignmentExplanation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAssignmentExplanation", ctx, explanation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAssignmentExplanation indicates an expected call of AddAssignmentExplanation.
+func (mr *MockRepositoryInterfaceMockRecorder) AddAssignmentExplanation(ctx, explanation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAssignmentExplanation", reflect.TypeOf((*MockRepositoryInterface)(nil).AddAssignmentExplanation), ctx, explanation)
+}
+
+// AddUnavailability mocks base method.
+func (m *MockRepositoryInterface) AddUnavailability(ctx context.Context, period *models.Unavailability) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnavailability", ctx, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUnavailability indicates an expected call of AddUnavailability.
+func (mr *MockRepositoryInterfaceMockRecorder) AddUnavailability(ctx, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnavailability", reflect.TypeOf((*MockRepositoryInterface)(nil).AddUnavailability), ctx, period)
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockRepositoryInterface) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockRepositoryInterfaceMockRecorder) ClaimWebhookDeliveries(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockRepositoryInterface)(nil).ClaimWebhookDeliveries), ctx, limit, lease)
+}
+
+// CreateOwnershipRule mocks base method.
+func (m *MockRepositoryInterface) CreateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOwnershipRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOwnershipRule indicates an expected call of CreateOwnershipRule.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateOwnershipRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOwnershipRule", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateOwnershipRule), ctx, rule)
+}
+
 // CreatePullRequestAndReview mocks base method.
-func (m *MockRepositoryInterface) CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviews []*models.User) error {
+func (m *MockRepositoryInterface) CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviews []*models.User, events []*models.PrEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePullRequestAndReview", ctx, pr, reviews)
+	ret := m.ctrl.Call(m, "CreatePullRequestAndReview", ctx, pr, reviews, events)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreatePullRequestAndReview indicates an expected call of CreatePullRequestAndReview.
-func (mr *MockRepositoryInterfaceMockRecorder) CreatePullRequestAndReview(ctx, pr, reviews interface{}) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) CreatePullRequestAndReview(ctx, pr, reviews, events interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequestAndReview", reflect.TypeOf((*MockRepositoryInterface)(nil).CreatePullRequestAndReview), ctx, pr, reviews)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequestAndReview", reflect.TypeOf((*MockRepositoryInterface)(nil).CreatePullRequestAndReview), ctx, pr, reviews, events)
 }
 
 // CreateTeam mocks base method.
@@ -64,18 +122,135 @@ func (mr *MockRepositoryInterfaceMockRecorder) CreateTeam(ctx, team interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateTeam), ctx, team)
 }
 
+// CreateWebhookSubscription mocks base method.
+func (m *MockRepositoryInterface) CreateWebhookSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateWebhookSubscription(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateWebhookSubscription), ctx, subscription)
+}
+
+// DeactivateUsers mocks base method.
+func (m *MockRepositoryInterface) DeactivateUsers(ctx context.Context, userIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUsers", ctx, userIds, changes, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUsers indicates an expected call of DeactivateUsers.
+func (mr *MockRepositoryInterfaceMockRecorder) DeactivateUsers(ctx, userIds, changes, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUsers", reflect.TypeOf((*MockRepositoryInterface)(nil).DeactivateUsers), ctx, userIds, changes, events)
+}
+
+// DeleteOwnershipRule mocks base method.
+func (m *MockRepositoryInterface) DeleteOwnershipRule(ctx context.Context, ruleId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOwnershipRule", ctx, ruleId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOwnershipRule indicates an expected call of DeleteOwnershipRule.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteOwnershipRule(ctx, ruleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOwnershipRule", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteOwnershipRule), ctx, ruleId)
+}
+
 // DeleteReview mocks base method.
-func (m *MockRepositoryInterface) DeleteReview(ctx context.Context, prId, userId int) error {
+func (m *MockRepositoryInterface) DeleteReview(ctx context.Context, prId, userId int, events []*models.PrEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteReview", ctx, prId, userId)
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, prId, userId, events)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteReview indicates an expected call of DeleteReview.
-func (mr *MockRepositoryInterfaceMockRecorder) DeleteReview(ctx, prId, userId interface{}) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteReview(ctx, prId, userId, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteReview), ctx, prId, userId, events)
+}
+
+// DeleteUnavailability mocks base method.
+func (m *MockRepositoryInterface) DeleteUnavailability(ctx context.Context, periodId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnavailability", ctx, periodId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnavailability indicates an expected call of DeleteUnavailability.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteUnavailability(ctx, periodId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnavailability", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteUnavailability), ctx, periodId)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockRepositoryInterface) DeleteWebhookSubscription(ctx context.Context, subscriptionId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, subscriptionId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteWebhookSubscription(ctx, subscriptionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteWebhookSubscription), ctx, subscriptionId)
+}
+
+// ForgetInboundDelivery mocks base method.
+func (m *MockRepositoryInterface) ForgetInboundDelivery(ctx context.Context, provider, deliveryId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetInboundDelivery", ctx, provider, deliveryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgetInboundDelivery indicates an expected call of ForgetInboundDelivery.
+func (mr *MockRepositoryInterfaceMockRecorder) ForgetInboundDelivery(ctx, provider, deliveryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetInboundDelivery", reflect.TypeOf((*MockRepositoryInterface)(nil).ForgetInboundDelivery), ctx, provider, deliveryId)
+}
+
+// GetAssignmentExplanations mocks base method.
+func (m *MockRepositoryInterface) GetAssignmentExplanations(ctx context.Context, prId int) ([]*models.AssignmentExplanation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentExplanations", ctx, prId)
+	ret0, _ := ret[0].([]*models.AssignmentExplanation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentExplanations indicates an expected call of GetAssignmentExplanations.
+func (mr *MockRepositoryInterfaceMockRecorder) GetAssignmentExplanations(ctx, prId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentExplanations", reflect.TypeOf((*MockRepositoryInterface)(nil).GetAssignmentExplanations), ctx, prId)
+}
+
+// GetDeadWebhookDeliveries mocks base method.
+func (m *MockRepositoryInterface) GetDeadWebhookDeliveries(ctx context.Context) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadWebhookDeliveries", ctx)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadWebhookDeliveries indicates an expected call of GetDeadWebhookDeliveries.
+func (mr *MockRepositoryInterfaceMockRecorder) GetDeadWebhookDeliveries(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteReview), ctx, prId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadWebhookDeliveries", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDeadWebhookDeliveries), ctx)
 }
 
 // GetListReviewsByUserId mocks base method.
@@ -93,6 +268,81 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetListReviewsByUserId(ctx, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListReviewsByUserId", reflect.TypeOf((*MockRepositoryInterface)(nil).GetListReviewsByUserId), ctx, userId)
 }
 
+// GetOpenReviewCounts mocks base method.
+func (m *MockRepositoryInterface) GetOpenReviewCounts(ctx context.Context, userIds []int) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReviewCounts", ctx, userIds)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReviewCounts indicates an expected call of GetOpenReviewCounts.
+func (mr *MockRepositoryInterfaceMockRecorder) GetOpenReviewCounts(ctx, userIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReviewCounts", reflect.TypeOf((*MockRepositoryInterface)(nil).GetOpenReviewCounts), ctx, userIds)
+}
+
+// GetOwnershipRuleById mocks base method.
+func (m *MockRepositoryInterface) GetOwnershipRuleById(ctx context.Context, ruleId int) (*models.OwnershipRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnershipRuleById", ctx, ruleId)
+	ret0, _ := ret[0].(*models.OwnershipRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnershipRuleById indicates an expected call of GetOwnershipRuleById.
+func (mr *MockRepositoryInterfaceMockRecorder) GetOwnershipRuleById(ctx, ruleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnershipRuleById", reflect.TypeOf((*MockRepositoryInterface)(nil).GetOwnershipRuleById), ctx, ruleId)
+}
+
+// GetOwnershipRules mocks base method.
+func (m *MockRepositoryInterface) GetOwnershipRules(ctx context.Context, teamId int) ([]*models.OwnershipRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnershipRules", ctx, teamId)
+	ret0, _ := ret[0].([]*models.OwnershipRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnershipRules indicates an expected call of GetOwnershipRules.
+func (mr *MockRepositoryInterfaceMockRecorder) GetOwnershipRules(ctx, teamId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnershipRules", reflect.TypeOf((*MockRepositoryInterface)(nil).GetOwnershipRules), ctx, teamId)
+}
+
+// GetPairings mocks base method.
+func (m *MockRepositoryInterface) GetPairings(ctx context.Context, authorId int, userIds []int, since time.Time) (map[int]models.Pairing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairings", ctx, authorId, userIds, since)
+	ret0, _ := ret[0].(map[int]models.Pairing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairings indicates an expected call of GetPairings.
+func (mr *MockRepositoryInterfaceMockRecorder) GetPairings(ctx, authorId, userIds, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairings", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPairings), ctx, authorId, userIds, since)
+}
+
+// GetPartnerTeams mocks base method.
+func (m *MockRepositoryInterface) GetPartnerTeams(ctx context.Context, teamId int) ([]*models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartnerTeams", ctx, teamId)
+	ret0, _ := ret[0].([]*models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartnerTeams indicates an expected call of GetPartnerTeams.
+func (mr *MockRepositoryInterfaceMockRecorder) GetPartnerTeams(ctx, teamId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartnerTeams", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPartnerTeams), ctx, teamId)
+}
+
 // GetPullRequestById mocks base method.
 func (m *MockRepositoryInterface) GetPullRequestById(ctx context.Context, prSystemId string) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +358,66 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetPullRequestById(ctx, prSystemI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestById", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPullRequestById), ctx, prSystemId)
 }
 
+// GetPullRequestEvents mocks base method.
+func (m *MockRepositoryInterface) GetPullRequestEvents(ctx context.Context, prId int) ([]*models.PrEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestEvents", ctx, prId)
+	ret0, _ := ret[0].([]*models.PrEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestEvents indicates an expected call of GetPullRequestEvents.
+func (mr *MockRepositoryInterfaceMockRecorder) GetPullRequestEvents(ctx, prId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestEvents", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPullRequestEvents), ctx, prId)
+}
+
+// GetReviewerStats mocks base method.
+func (m *MockRepositoryInterface) GetReviewerStats(ctx context.Context, from, to sql.NullTime, teamName string) ([]*models.ReviewerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerStats", ctx, from, to, teamName)
+	ret0, _ := ret[0].([]*models.ReviewerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerStats indicates an expected call of GetReviewerStats.
+func (mr *MockRepositoryInterfaceMockRecorder) GetReviewerStats(ctx, from, to, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerStats", reflect.TypeOf((*MockRepositoryInterface)(nil).GetReviewerStats), ctx, from, to, teamName)
+}
+
+// GetStartedUnavailability mocks base method.
+func (m *MockRepositoryInterface) GetStartedUnavailability(ctx context.Context) ([]*models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStartedUnavailability", ctx)
+	ret0, _ := ret[0].([]*models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStartedUnavailability indicates an expected call of GetStartedUnavailability.
+func (mr *MockRepositoryInterfaceMockRecorder) GetStartedUnavailability(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartedUnavailability", reflect.TypeOf((*MockRepositoryInterface)(nil).GetStartedUnavailability), ctx)
+}
+
+// GetTeamById mocks base method.
+func (m *MockRepositoryInterface) GetTeamById(ctx context.Context, teamId int) (*models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamById", ctx, teamId)
+	ret0, _ := ret[0].(*models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamById indicates an expected call of GetTeamById.
+func (mr *MockRepositoryInterfaceMockRecorder) GetTeamById(ctx, teamId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamById", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTeamById), ctx, teamId)
+}
+
 // GetTeamByName mocks base method.
 func (m *MockRepositoryInterface) GetTeamByName(ctx context.Context, teamName string) (*models.Team, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +463,93 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetUserBySystemId(ctx, systemId i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBySystemId", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserBySystemId), ctx, systemId)
 }
 
+// GetUserContacts mocks base method.
+func (m *MockRepositoryInterface) GetUserContacts(ctx context.Context, userIds []int) ([]*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserContacts", ctx, userIds)
+	ret0, _ := ret[0].([]*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserContacts indicates an expected call of GetUserContacts.
+func (mr *MockRepositoryInterfaceMockRecorder) GetUserContacts(ctx, userIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserContacts", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserContacts), ctx, userIds)
+}
+
+// GetUserUnavailability mocks base method.
+func (m *MockRepositoryInterface) GetUserUnavailability(ctx context.Context, userId int) ([]*models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserUnavailability", ctx, userId)
+	ret0, _ := ret[0].([]*models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserUnavailability indicates an expected call of GetUserUnavailability.
+func (mr *MockRepositoryInterfaceMockRecorder) GetUserUnavailability(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserUnavailability", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserUnavailability), ctx, userId)
+}
+
+// GetWebhookSubscriptions mocks base method.
+func (m *MockRepositoryInterface) GetWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptions", ctx)
+	ret0, _ := ret[0].([]*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptions indicates an expected call of GetWebhookSubscriptions.
+func (mr *MockRepositoryInterfaceMockRecorder) GetWebhookSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptions", reflect.TypeOf((*MockRepositoryInterface)(nil).GetWebhookSubscriptions), ctx)
+}
+
+// MarkUnavailabilityHandled mocks base method.
+func (m *MockRepositoryInterface) MarkUnavailabilityHandled(ctx context.Context, periodId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUnavailabilityHandled", ctx, periodId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUnavailabilityHandled indicates an expected call of MarkUnavailabilityHandled.
+func (mr *MockRepositoryInterfaceMockRecorder) MarkUnavailabilityHandled(ctx, periodId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnavailabilityHandled", reflect.TypeOf((*MockRepositoryInterface)(nil).MarkUnavailabilityHandled), ctx, periodId)
+}
+
+// MarkWebhookDead mocks base method.
+func (m *MockRepositoryInterface) MarkWebhookDead(ctx context.Context, deliveryId int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDead", ctx, deliveryId, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDead indicates an expected call of MarkWebhookDead.
+func (mr *MockRepositoryInterfaceMockRecorder) MarkWebhookDead(ctx, deliveryId, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDead", reflect.TypeOf((*MockRepositoryInterface)(nil).MarkWebhookDead), ctx, deliveryId, lastError)
+}
+
+// MarkWebhookDelivered mocks base method.
+func (m *MockRepositoryInterface) MarkWebhookDelivered(ctx context.Context, deliveryId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDelivered", ctx, deliveryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDelivered indicates an expected call of MarkWebhookDelivered.
+func (mr *MockRepositoryInterfaceMockRecorder) MarkWebhookDelivered(ctx, deliveryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDelivered", reflect.TypeOf((*MockRepositoryInterface)(nil).MarkWebhookDelivered), ctx, deliveryId)
+}
+
 // PullRequestExists mocks base method.
 func (m *MockRepositoryInterface) PullRequestExists(ctx context.Context, prSystemID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -168,18 +565,47 @@ func (mr *MockRepositoryInterfaceMockRecorder) PullRequestExists(ctx, prSystemID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestExists", reflect.TypeOf((*MockRepositoryInterface)(nil).PullRequestExists), ctx, prSystemID)
 }
 
+// RecordInboundDelivery mocks base method.
+func (m *MockRepositoryInterface) RecordInboundDelivery(ctx context.Context, provider, deliveryId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordInboundDelivery", ctx, provider, deliveryId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordInboundDelivery indicates an expected call of RecordInboundDelivery.
+func (mr *MockRepositoryInterfaceMockRecorder) RecordInboundDelivery(ctx, provider, deliveryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInboundDelivery", reflect.TypeOf((*MockRepositoryInterface)(nil).RecordInboundDelivery), ctx, provider, deliveryId)
+}
+
 // ReplaceReviewers mocks base method.
-func (m *MockRepositoryInterface) ReplaceReviewers(ctx context.Context, prId, oldReviewerId, newReviewerId int) error {
+func (m *MockRepositoryInterface) ReplaceReviewers(ctx context.Context, prId, oldReviewerId, newReviewerId int, events []*models.PrEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceReviewers", ctx, prId, oldReviewerId, newReviewerId)
+	ret := m.ctrl.Call(m, "ReplaceReviewers", ctx, prId, oldReviewerId, newReviewerId, events)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReplaceReviewers indicates an expected call of ReplaceReviewers.
-func (mr *MockRepositoryInterfaceMockRecorder) ReplaceReviewers(ctx, prId, oldReviewerId, newReviewerId interface{}) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) ReplaceReviewers(ctx, prId, oldReviewerId, newReviewerId, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewers", reflect.TypeOf((*MockRepositoryInterface)(nil).ReplaceReviewers), ctx, prId, oldReviewerId, newReviewerId, events)
+}
+
+// RetryWebhookDelivery mocks base method.
+func (m *MockRepositoryInterface) RetryWebhookDelivery(ctx context.Context, deliveryId int, lastError string, delay time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryWebhookDelivery", ctx, deliveryId, lastError, delay)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryWebhookDelivery indicates an expected call of RetryWebhookDelivery.
+func (mr *MockRepositoryInterfaceMockRecorder) RetryWebhookDelivery(ctx, deliveryId, lastError, delay interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewers", reflect.TypeOf((*MockRepositoryInterface)(nil).ReplaceReviewers), ctx, prId, oldReviewerId, newReviewerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryWebhookDelivery", reflect.TypeOf((*MockRepositoryInterface)(nil).RetryWebhookDelivery), ctx, deliveryId, lastError, delay)
 }
 
 // SetIsActive mocks base method.
@@ -198,18 +624,195 @@ func (mr *MockRepositoryInterfaceMockRecorder) SetIsActive(ctx, userID, isActive
 }
 
 // SetMergedStatusPullRequest mocks base method.
-func (m *MockRepositoryInterface) SetMergedStatusPullRequest(ctx context.Context, prId int) (sql.NullTime, error) {
+func (m *MockRepositoryInterface) SetMergedStatusPullRequest(ctx context.Context, prId int, events []*models.PrEvent) (sql.NullTime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMergedStatusPullRequest", ctx, prId)
+	ret := m.ctrl.Call(m, "SetMergedStatusPullRequest", ctx, prId, events)
 	ret0, _ := ret[0].(sql.NullTime)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetMergedStatusPullRequest indicates an expected call of SetMergedStatusPullRequest.
-func (mr *MockRepositoryInterfaceMockRecorder) SetMergedStatusPullRequest(ctx, prId interface{}) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) SetMergedStatusPullRequest(ctx, prId, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMergedStatusPullRequest", reflect.TypeOf((*MockRepositoryInterface)(nil).SetMergedStatusPullRequest), ctx, prId, events)
+}
+
+// SetPullRequestStatus mocks base method.
+func (m *MockRepositoryInterface) SetPullRequestStatus(ctx context.Context, prId int, status string, reviewers []*models.User, events []*models.PrEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPullRequestStatus", ctx, prId, status, reviewers, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPullRequestStatus indicates an expected call of SetPullRequestStatus.
+func (mr *MockRepositoryInterfaceMockRecorder) SetPullRequestStatus(ctx, prId, status, reviewers, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPullRequestStatus", reflect.TypeOf((*MockRepositoryInterface)(nil).SetPullRequestStatus), ctx, prId, status, reviewers, events)
+}
+
+// SetReviewDecision mocks base method.
+func (m *MockRepositoryInterface) SetReviewDecision(ctx context.Context, prId, userId int, decision string, events []*models.PrEvent) (sql.NullTime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewDecision", ctx, prId, userId, decision, events)
+	ret0, _ := ret[0].(sql.NullTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReviewDecision indicates an expected call of SetReviewDecision.
+func (mr *MockRepositoryInterfaceMockRecorder) SetReviewDecision(ctx, prId, userId, decision, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewDecision", reflect.TypeOf((*MockRepositoryInterface)(nil).SetReviewDecision), ctx, prId, userId, decision, events)
+}
+
+// SetTeamMergePolicy mocks base method.
+func (m *MockRepositoryInterface) SetTeamMergePolicy(ctx context.Context, teamName string, policy models.MergePolicy) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamMergePolicy", ctx, teamName, policy)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamMergePolicy indicates an expected call of SetTeamMergePolicy.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamMergePolicy(ctx, teamName, policy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMergedStatusPullRequest", reflect.TypeOf((*MockRepositoryInterface)(nil).SetMergedStatusPullRequest), ctx, prId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamMergePolicy", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamMergePolicy), ctx, teamName, policy)
+}
+
+// SetTeamPairingLookback mocks base method.
+func (m *MockRepositoryInterface) SetTeamPairingLookback(ctx context.Context, teamName string, days int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamPairingLookback", ctx, teamName, days)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamPairingLookback indicates an expected call of SetTeamPairingLookback.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamPairingLookback(ctx, teamName, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamPairingLookback", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamPairingLookback), ctx, teamName, days)
+}
+
+// SetTeamPartners mocks base method.
+func (m *MockRepositoryInterface) SetTeamPartners(ctx context.Context, teamId int, partnerIds []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamPartners", ctx, teamId, partnerIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeamPartners indicates an expected call of SetTeamPartners.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamPartners(ctx, teamId, partnerIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamPartners", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamPartners), ctx, teamId, partnerIds)
+}
+
+// SetTeamReassignPool mocks base method.
+func (m *MockRepositoryInterface) SetTeamReassignPool(ctx context.Context, teamName, pool string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamReassignPool", ctx, teamName, pool)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamReassignPool indicates an expected call of SetTeamReassignPool.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamReassignPool(ctx, teamName, pool interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamReassignPool", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamReassignPool), ctx, teamName, pool)
+}
+
+// SetTeamRequiredApprovals mocks base method.
+func (m *MockRepositoryInterface) SetTeamRequiredApprovals(ctx context.Context, teamName string, requiredApprovals int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamRequiredApprovals", ctx, teamName, requiredApprovals)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamRequiredApprovals indicates an expected call of SetTeamRequiredApprovals.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamRequiredApprovals(ctx, teamName, requiredApprovals interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamRequiredApprovals", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamRequiredApprovals), ctx, teamName, requiredApprovals)
+}
+
+// SetTeamReviewerStrategy mocks base method.
+func (m *MockRepositoryInterface) SetTeamReviewerStrategy(ctx context.Context, teamName, strategy string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamReviewerStrategy", ctx, teamName, strategy)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamReviewerStrategy indicates an expected call of SetTeamReviewerStrategy.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamReviewerStrategy(ctx, teamName, strategy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamReviewerStrategy", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamReviewerStrategy), ctx, teamName, strategy)
+}
+
+// SetTeamReviewersCount mocks base method.
+func (m *MockRepositoryInterface) SetTeamReviewersCount(ctx context.Context, teamName string, minReviewers, maxReviewers int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamReviewersCount", ctx, teamName, minReviewers, maxReviewers)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamReviewersCount indicates an expected call of SetTeamReviewersCount.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTeamReviewersCount(ctx, teamName, minReviewers, maxReviewers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamReviewersCount", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTeamReviewersCount), ctx, teamName, minReviewers, maxReviewers)
+}
+
+// SetUserContacts mocks base method.
+func (m *MockRepositoryInterface) SetUserContacts(ctx context.Context, contact *models.Contact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserContacts", ctx, contact)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserContacts indicates an expected call of SetUserContacts.
+func (mr *MockRepositoryInterfaceMockRecorder) SetUserContacts(ctx, contact interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserContacts", reflect.TypeOf((*MockRepositoryInterface)(nil).SetUserContacts), ctx, contact)
+}
+
+// SetUserMaxOpenReviews mocks base method.
+func (m *MockRepositoryInterface) SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserMaxOpenReviews", ctx, userID, maxOpenReviews)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserMaxOpenReviews indicates an expected call of SetUserMaxOpenReviews.
+func (mr *MockRepositoryInterfaceMockRecorder) SetUserMaxOpenReviews(ctx, userID, maxOpenReviews interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserMaxOpenReviews", reflect.TypeOf((*MockRepositoryInterface)(nil).SetUserMaxOpenReviews), ctx, userID, maxOpenReviews)
+}
+
+// SetUserTags mocks base method.
+func (m *MockRepositoryInterface) SetUserTags(ctx context.Context, userID string, tags []string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserTags", ctx, userID, tags)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserTags indicates an expected call of SetUserTags.
+func (mr *MockRepositoryInterfaceMockRecorder) SetUserTags(ctx, userID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserTags", reflect.TypeOf((*MockRepositoryInterface)(nil).SetUserTags), ctx, userID, tags)
 }
 
 // TeamExists mocks base method.
@@ -226,3 +829,46 @@ func (mr *MockRepositoryInterfaceMockRecorder) TeamExists(ctx, teamName interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamExists", reflect.TypeOf((*MockRepositoryInterface)(nil).TeamExists), ctx, teamName)
 }
+
+// UpdateOwnershipRule mocks base method.
+func (m *MockRepositoryInterface) UpdateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOwnershipRule", ctx, rule)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOwnershipRule indicates an expected call of UpdateOwnershipRule.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateOwnershipRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnershipRule", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateOwnershipRule), ctx, rule)
+}
+
+// UpdateReviewers mocks base method.
+func (m *MockRepositoryInterface) UpdateReviewers(ctx context.Context, prId int, added, removed []*models.User, events []*models.PrEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewers", ctx, prId, added, removed, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewers indicates an expected call of UpdateReviewers.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateReviewers(ctx, prId, added, removed, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewers", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateReviewers), ctx, prId, added, removed, events)
+}
+
+// UpdateTeamMembers mocks base method.
+func (m *MockRepositoryInterface) UpdateTeamMembers(ctx context.Context, teamId int, upserts []*models.User, removeIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamMembers", ctx, teamId, upserts, removeIds, changes, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamMembers indicates an expected call of UpdateTeamMembers.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateTeamMembers(ctx, teamId, upserts, removeIds, changes, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamMembers", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateTeamMembers), ctx, teamId, upserts, removeIds, changes, events)
+}
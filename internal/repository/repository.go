@@ -10,26 +10,107 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type RepositoryInterface interface {
 	TeamExists(ctx context.Context, teamName string) (bool, error)
 	CreateTeam(ctx context.Context, team *models.Team) error
 	GetTeamByName(ctx context.Context, teamName string) (*models.Team, error)
+	GetTeamById(ctx context.Context, teamId int) (*models.Team, error)
+	SetTeamReviewerStrategy(ctx context.Context, teamName string, strategy string) (bool, error)
+	SetTeamReviewersCount(ctx context.Context, teamName string, minReviewers int, maxReviewers int) (bool, error)
+	SetTeamRequiredApprovals(ctx context.Context, teamName string, requiredApprovals int) (bool, error)
+	SetTeamMergePolicy(ctx context.Context, teamName string, policy models.MergePolicy) (bool, error)
+	SetTeamPairingLookback(ctx context.Context, teamName string, days int) (bool, error)
+	SetTeamReassignPool(ctx context.Context, teamName string, pool string) (bool, error)
+	UpdateTeamMembers(ctx context.Context, teamId int, upserts []*models.User, removeIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
+	SetUserTags(ctx context.Context, userID string, tags []string) (*models.User, error)
+	SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error)
+	SetUserContacts(ctx context.Context, contact *models.Contact) error
+	GetUserContacts(ctx context.Context, userIds []int) ([]*models.Contact, error)
+	AddUnavailability(ctx context.Context, period *models.Unavailability) error
+	GetUserUnavailability(ctx context.Context, userId int) ([]*models.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, periodId int) (bool, error)
+	GetStartedUnavailability(ctx context.Context) ([]*models.Unavailability, error)
+	MarkUnavailabilityHandled(ctx context.Context, periodId int) error
+	DeactivateUsers(ctx context.Context, userIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error
 	GetUserBySystemId(ctx context.Context, systemId string) (*models.User, error)
 	GetListReviewsByUserId(ctx context.Context, userId int) ([]*models.PullRequest, error)
 	PullRequestExists(ctx context.Context, prSystemID string) (bool, error)
 	GetTeamMembers(ctx context.Context, teamId int) ([]*models.User, error)
-	CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviews []*models.User) error
+	GetPartnerTeams(ctx context.Context, teamId int) ([]*models.Team, error)
+	SetTeamPartners(ctx context.Context, teamId int, partnerIds []int) error
+	GetOwnershipRules(ctx context.Context, teamId int) ([]*models.OwnershipRule, error)
+	GetOwnershipRuleById(ctx context.Context, ruleId int) (*models.OwnershipRule, error)
+	CreateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) error
+	UpdateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) (bool, error)
+	DeleteOwnershipRule(ctx context.Context, ruleId int) (bool, error)
+	GetOpenReviewCounts(ctx context.Context, userIds []int) (map[int]int, error)
+	GetPairings(ctx context.Context, authorId int, userIds []int, since time.Time) (map[int]models.Pairing, error)
+	CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviews []*models.User, events []*models.PrEvent) error
 	GetPullRequestById(ctx context.Context, prSystemId string) (*models.PullRequest, error)
-	SetMergedStatusPullRequest(ctx context.Context, prId int) (sql.NullTime, error)
-	ReplaceReviewers(ctx context.Context, prId int, oldReviewerId int, newReviewerId int) error
-	DeleteReview(ctx context.Context, prId int, userId int) error
+	SetMergedStatusPullRequest(ctx context.Context, prId int, events []*models.PrEvent) (sql.NullTime, error)
+	SetPullRequestStatus(ctx context.Context, prId int, status string, reviewers []*models.User, events []*models.PrEvent) error
+	SetReviewDecision(ctx context.Context, prId int, userId int, decision string, events []*models.PrEvent) (sql.NullTime, error)
+	ReplaceReviewers(ctx context.Context, prId int, oldReviewerId int, newReviewerId int, events []*models.PrEvent) error
+	DeleteReview(ctx context.Context, prId int, userId int, events []*models.PrEvent) error
+	UpdateReviewers(ctx context.Context, prId int, added []*models.User, removed []*models.User, events []*models.PrEvent) error
+	GetPullRequestEvents(ctx context.Context, prId int) ([]*models.PrEvent, error)
+	AddAssignmentExplanation(ctx context.Context, explanation *models.AssignmentExplanation) error
+	CreateWebhookSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	GetWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, subscriptionId int) (bool, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	MarkWebhookDelivered(ctx context.Context, deliveryId int) error
+	RetryWebhookDelivery(ctx context.Context, deliveryId int, lastError string, delay time.Duration) error
+	MarkWebhookDead(ctx context.Context, deliveryId int, lastError string) error
+	GetDeadWebhookDeliveries(ctx context.Context) ([]*models.WebhookDelivery, error)
+	RecordInboundDelivery(ctx context.Context, provider string, deliveryId string) (bool, error)
+	ForgetInboundDelivery(ctx context.Context, provider string, deliveryId string) error
+	GetAssignmentExplanations(ctx context.Context, prId int) ([]*models.AssignmentExplanation, error)
+	GetReviewerStats(ctx context.Context, from sql.NullTime, to sql.NullTime, teamName string) ([]*models.ReviewerStats, error)
 }
 
+// unavailableNow tells whether the user of the row is inside one of the
+// unavailability periods; the users table has to be aliased as u.
+const unavailableNow = `
+        EXISTS (
+            SELECT 1
+            FROM user_unavailability AS v
+            WHERE v.user_id = u.user_id AND v.starts_at <= NOW() AND v.ends_at > NOW()
+        )`
+
+// openReviewsNow counts open reviews held by the user of the row; the users
+// table has to be aliased as u and statusParam is the placeholder the query
+// binds models.StatusOpen to.
+func openReviewsNow(statusParam string) string {
+	return `
+        (
+            SELECT COUNT(*)
+            FROM pull_request_reviewers AS rv
+            JOIN pull_requests AS rp ON rp.pull_request_id = rv.pull_request_id
+            WHERE rv.user_id = u.user_id AND rp.status = ` + statusParam + `
+        )`
+}
+
+const upsertUser = `
+        INSERT INTO users (system_id, user_name, team_id, is_active, review_weight, tags, max_open_reviews)
+        VALUES ($1, $2, $3, $4, GREATEST($5, 1), COALESCE($6::TEXT[], '{}'), $7)
+        ON CONFLICT (system_id) DO UPDATE
+        SET
+            user_name = EXCLUDED.user_name,
+            team_id = EXCLUDED.team_id,
+            is_active = EXCLUDED.is_active,
+            review_weight = CASE WHEN $5 > 0 THEN $5 ELSE users.review_weight END,
+            tags = COALESCE($6::TEXT[], users.tags),
+            max_open_reviews = COALESCE($7, users.max_open_reviews)
+        RETURNING user_id;
+    `
+
 type Database struct {
 	conn *sql.DB
 }
@@ -77,33 +158,45 @@ func (db *Database) CreateTeam(ctx context.Context, team *models.Team) error {
 	}
 
 	const insertTeam = `
-        INSERT INTO teams (team_name)
-        VALUES ($1)
+        INSERT INTO teams (
+            team_name,
+            reviewer_strategy,
+            min_reviewers,
+            max_reviewers,
+            required_approvals,
+            require_reviewer,
+            no_changes_requested,
+            no_self_approval,
+            reassign_pool,
+            pairing_lookback_days
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
         RETURNING team_id;
     `
-	if err := tx.QueryRowContext(ctx, insertTeam, team.TeamName).Scan(&team.TeamId); err != nil {
+	err = tx.QueryRowContext(
+		ctx,
+		insertTeam,
+		team.TeamName,
+		team.ReviewerStrategy,
+		team.MinReviewers,
+		team.MaxReviewers,
+		team.RequiredApprovals,
+		team.MergePolicy.RequireReviewer,
+		team.MergePolicy.NoChangesRequested,
+		team.MergePolicy.NoSelfApproval,
+		team.ReassignPool,
+		team.PairingLookbackDays,
+	).Scan(&team.TeamId)
+
+	if err != nil {
 		_ = tx.Rollback()
 		logs.PrintLog(ctx, "[repository] CreateTeam", err.Error())
 		return err
 	}
 
-	const insertUser = `
-        INSERT INTO users (system_id, user_name, team_id, is_active)
-        VALUES ($1, $2, $3, $4)
-        RETURNING user_id;
-    `
-
+	// existing users are moved to the new team instead of failing on system_id
 	for _, member := range team.TeamMembers {
-		var newUserID int
-		err := tx.QueryRowContext(
-			ctx,
-			insertUser,
-			member.SystemId,
-			member.UserName,
-			team.TeamId,
-			member.IsActive,
-		).Scan(&newUserID)
-
+		err := upsertMember(ctx, tx, team.TeamId, member)
 		if err != nil {
 			_ = tx.Rollback()
 			logs.PrintLog(ctx, "[repository] CreateTeam", err.Error())
@@ -119,9 +212,183 @@ func (db *Database) CreateTeam(ctx context.Context, team *models.Team) error {
 	return nil
 }
 
+func upsertMember(ctx context.Context, tx *sql.Tx, teamId int, member *models.User) error {
+	return tx.QueryRowContext(
+		ctx,
+		upsertUser,
+		member.SystemId,
+		member.UserName,
+		teamId,
+		member.IsActive,
+		member.ReviewWeight,
+		pq.Array(member.Tags),
+		member.MaxOpenReviews,
+	).Scan(&member.UserId)
+}
+
+func applyReviewerChanges(ctx context.Context, tx *sql.Tx, changes []*models.ReviewerChange) error {
+	const deleteQuery = `
+        DELETE FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND user_id = $2;
+    `
+
+	const insertQuery = `
+        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	for _, c := range changes {
+		if _, err := tx.ExecContext(ctx, deleteQuery, c.PullRequestId, c.OldReviewer.UserId); err != nil {
+			return err
+		}
+
+		if c.NewReviewer == nil {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, insertQuery, c.PullRequestId, c.NewReviewer.UserId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertEvents appends events to the pull request history and queues them
+// for the webhook subscriptions asking for their type.
+func insertEvents(ctx context.Context, tx *sql.Tx, events []*models.PrEvent) error {
+	const query = `
+        INSERT INTO pr_events (pull_request_id, event_type, actor, old_reviewer_id, new_reviewer_id, reason, selection_seed)
+        VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, 0), NULLIF($5, 0), $6, $7)
+        RETURNING event_id;
+    `
+
+	for _, e := range events {
+		err := tx.QueryRowContext(
+			ctx,
+			query,
+			e.PullRequestId,
+			e.EventType,
+			e.Actor,
+			e.OldReviewerId,
+			e.NewReviewerId,
+			e.Reason,
+			e.Seed,
+		).Scan(&e.EventId)
+		if err != nil {
+			return err
+		}
+
+		if webhookType, ok := models.WebhookEventTypes[e.EventType]; ok {
+			if err := enqueueWebhooks(ctx, tx, e.EventId, webhookType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// enqueueWebhooks writes an outbox delivery of a history event for every
+// subscription of its webhook type.
+func enqueueWebhooks(ctx context.Context, tx *sql.Tx, eventId int, webhookType string) error {
+	const query = `
+        INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+        SELECT
+            s.subscription_id,
+            $2,
+            jsonb_strip_nulls(jsonb_build_object(
+                'event_id', e.event_id,
+                'event_type', $2::TEXT,
+                'pull_request_id', pr.system_id,
+                'pull_request_name', pr.pull_request_name,
+                'author_id', au.system_id,
+                'status', pr.status,
+                'actor', e.actor,
+                'old_reviewer_id', ou.system_id,
+                'new_reviewer_id', nu.system_id,
+                'reason', e.reason,
+                'created_at', to_char(e.created_at, 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+            ))
+        FROM pr_events AS e
+        JOIN pull_requests AS pr ON pr.pull_request_id = e.pull_request_id
+        JOIN users AS au ON au.user_id = pr.author_id
+        LEFT JOIN users AS ou ON ou.user_id = e.old_reviewer_id
+        LEFT JOIN users AS nu ON nu.user_id = e.new_reviewer_id
+        JOIN webhook_subscriptions AS s ON $2 = ANY(s.event_types)
+        WHERE e.event_id = $1;
+    `
+
+	_, err := tx.ExecContext(ctx, query, eventId, webhookType)
+	return err
+}
+
+func (db *Database) UpdateTeamMembers(ctx context.Context, teamId int, upserts []*models.User, removeIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+		return err
+	}
+
+	for _, member := range upserts {
+		if err := upsertMember(ctx, tx, teamId, member); err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+			return err
+		}
+	}
+
+	const removeQuery = `
+        UPDATE users
+        SET
+            team_id = NULL,
+            is_active = FALSE
+        WHERE team_id = $1 AND user_id = ANY($2);
+    `
+
+	if len(removeIds) > 0 {
+		if _, err := tx.ExecContext(ctx, removeQuery, teamId, pq.Array(removeIds)); err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+			return err
+		}
+	}
+
+	if err := applyReviewerChanges(ctx, tx, changes); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+		return err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateTeamMembers", err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (db *Database) GetTeamByName(ctx context.Context, teamName string) (*models.Team, error) {
 	const selectTeam = `
-        SELECT team_id, team_name
+        SELECT
+            team_id,
+            team_name,
+            reviewer_strategy,
+            min_reviewers,
+            max_reviewers,
+            required_approvals,
+            require_reviewer,
+            no_changes_requested,
+            no_self_approval,
+            reassign_pool,
+            pairing_lookback_days
         FROM teams
         WHERE team_name = $1;
     `
@@ -129,7 +396,19 @@ func (db *Database) GetTeamByName(ctx context.Context, teamName string) (*models
 	var team models.Team
 
 	err := db.conn.QueryRowContext(ctx, selectTeam, teamName).
-		Scan(&team.TeamId, &team.TeamName)
+		Scan(
+			&team.TeamId,
+			&team.TeamName,
+			&team.ReviewerStrategy,
+			&team.MinReviewers,
+			&team.MaxReviewers,
+			&team.RequiredApprovals,
+			&team.MergePolicy.RequireReviewer,
+			&team.MergePolicy.NoChangesRequested,
+			&team.MergePolicy.NoSelfApproval,
+			&team.ReassignPool,
+			&team.PairingLookbackDays,
+		)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		logs.PrintLog(ctx, "[repository] GetTeamByName", err.Error())
@@ -141,13 +420,22 @@ func (db *Database) GetTeamByName(ctx context.Context, teamName string) (*models
 		return nil, err
 	}
 
-	const selectMembers = `
-        SELECT user_id, system_id, user_name, team_id, is_active
-        FROM users
-        WHERE team_id = $1;
+	selectMembers := `
+        SELECT
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            u.team_id,
+            u.is_active,
+            u.review_weight,
+            u.tags,
+            u.max_open_reviews,
+            ` + openReviewsNow("$2") + `
+        FROM users AS u
+        WHERE u.team_id = $1;
     `
 
-	rows, err := db.conn.QueryContext(ctx, selectMembers, team.TeamId)
+	rows, err := db.conn.QueryContext(ctx, selectMembers, team.TeamId, models.StatusOpen)
 	if err != nil {
 		logs.PrintLog(ctx, "[repository] GetTeamByName", err.Error())
 		return nil, err
@@ -166,6 +454,10 @@ func (db *Database) GetTeamByName(ctx context.Context, teamName string) (*models
 			&member.UserName,
 			&member.TeamId,
 			&member.IsActive,
+			&member.ReviewWeight,
+			pq.Array(&member.Tags),
+			&member.MaxOpenReviews,
+			&member.OpenReviews,
 		)
 
 		if err != nil {
@@ -173,12 +465,226 @@ func (db *Database) GetTeamByName(ctx context.Context, teamName string) (*models
 			return nil, err
 		}
 
+		member.TeamName = team.TeamName
 		team.TeamMembers = append(team.TeamMembers, member)
 	}
 
+	const selectPartners = `
+        SELECT t.team_name
+        FROM team_partners AS p
+        JOIN teams AS t ON t.team_id = p.partner_team_id
+        WHERE p.team_id = $1
+        ORDER BY p.priority;
+    `
+
+	partnerRows, err := db.conn.QueryContext(ctx, selectPartners, team.TeamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetTeamByName", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = partnerRows.Close()
+	}()
+
+	team.PartnerTeams = make([]string, 0)
+	for partnerRows.Next() {
+		var name string
+		if err := partnerRows.Scan(&name); err != nil {
+			logs.PrintLog(ctx, "[repository] GetTeamByName", err.Error())
+			return nil, err
+		}
+
+		team.PartnerTeams = append(team.PartnerTeams, name)
+	}
+
+	return &team, nil
+}
+
+func (db *Database) GetTeamById(ctx context.Context, teamId int) (*models.Team, error) {
+	const query = `
+        SELECT
+            team_id,
+            team_name,
+            reviewer_strategy,
+            min_reviewers,
+            max_reviewers,
+            required_approvals,
+            require_reviewer,
+            no_changes_requested,
+            no_self_approval,
+            reassign_pool,
+            pairing_lookback_days
+        FROM teams
+        WHERE team_id = $1;
+    `
+
+	var team models.Team
+
+	err := db.conn.QueryRowContext(ctx, query, teamId).
+		Scan(
+			&team.TeamId,
+			&team.TeamName,
+			&team.ReviewerStrategy,
+			&team.MinReviewers,
+			&team.MaxReviewers,
+			&team.RequiredApprovals,
+			&team.MergePolicy.RequireReviewer,
+			&team.MergePolicy.NoChangesRequested,
+			&team.MergePolicy.NoSelfApproval,
+			&team.ReassignPool,
+			&team.PairingLookbackDays,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		logs.PrintLog(ctx, "[repository] GetTeamById", err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetTeamById", err.Error())
+		return nil, err
+	}
+
 	return &team, nil
 }
 
+func (db *Database) SetTeamReviewerStrategy(ctx context.Context, teamName string, strategy string) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET reviewer_strategy = $2
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, strategy)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReviewerStrategy", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReviewerStrategy", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) SetTeamReviewersCount(ctx context.Context, teamName string, minReviewers int, maxReviewers int) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET
+            min_reviewers = $2,
+            max_reviewers = $3
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, minReviewers, maxReviewers)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReviewersCount", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReviewersCount", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) SetTeamRequiredApprovals(ctx context.Context, teamName string, requiredApprovals int) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET required_approvals = $2
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, requiredApprovals)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamRequiredApprovals", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamRequiredApprovals", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) SetTeamMergePolicy(ctx context.Context, teamName string, policy models.MergePolicy) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET
+            require_reviewer = $2,
+            no_changes_requested = $3,
+            no_self_approval = $4
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, policy.RequireReviewer, policy.NoChangesRequested, policy.NoSelfApproval)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamMergePolicy", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamMergePolicy", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) SetTeamReassignPool(ctx context.Context, teamName string, pool string) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET reassign_pool = $2
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, pool)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReassignPool", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamReassignPool", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) SetTeamPairingLookback(ctx context.Context, teamName string, days int) (bool, error) {
+	const query = `
+        UPDATE teams
+        SET pairing_lookback_days = $2
+        WHERE team_name = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, teamName, days)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamPairingLookback", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamPairingLookback", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (db *Database) SetIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error) {
 	const query = `
         UPDATE users
@@ -188,14 +694,15 @@ func (db *Database) SetIsActive(ctx context.Context, userID string, isActive boo
             users.user_id,
             users.system_id,
             users.user_name,
-            users.team_id,
-            users.is_active;
+            COALESCE(users.team_id, 0),
+            users.is_active,
+            users.tags;
     `
 
 	var user models.User
 	err := db.conn.
 		QueryRowContext(ctx, query, userID, isActive).
-		Scan(&user.UserId, &user.SystemId, &user.UserName, &user.TeamId, &user.IsActive)
+		Scan(&user.UserId, &user.SystemId, &user.UserName, &user.TeamId, &user.IsActive, pq.Array(&user.Tags))
 
 	if errors.Is(err, sql.ErrNoRows) {
 		logs.PrintLog(ctx, "[repository] SetIsActive", err.Error())
@@ -207,6 +714,10 @@ func (db *Database) SetIsActive(ctx context.Context, userID string, isActive boo
 		return nil, appErrors.ErrServerError
 	}
 
+	if user.TeamId == 0 {
+		return &user, nil
+	}
+
 	const teamQuery = `
             SELECT team_name 
             FROM teams 
@@ -222,353 +733,1846 @@ func (db *Database) SetIsActive(ctx context.Context, userID string, isActive boo
 	return &user, nil
 }
 
-func (db *Database) GetUserBySystemId(ctx context.Context, systemId string) (*models.User, error) {
-	const userQuery = `
-        SELECT 
-            user_id,
-			team_id
-        FROM users
-        WHERE system_id = $1;
+func (db *Database) SetUserTags(ctx context.Context, userID string, tags []string) (*models.User, error) {
+	const query = `
+        UPDATE users
+        SET tags = $2
+        WHERE system_id = $1
+        RETURNING
+            users.user_id,
+            users.system_id,
+            users.user_name,
+            COALESCE(users.team_id, 0),
+            COALESCE((SELECT team_name FROM teams WHERE team_id = users.team_id), ''),
+            users.is_active,
+            users.tags;
     `
 
 	var user models.User
-	user.SystemId = systemId
-	err := db.conn.QueryRowContext(ctx, userQuery, systemId).Scan(&user.UserId, &user.TeamId)
+	err := db.conn.
+		QueryRowContext(ctx, query, userID, pq.Array(tags)).
+		Scan(&user.UserId, &user.SystemId, &user.UserName, &user.TeamId, &user.TeamName, &user.IsActive, pq.Array(&user.Tags))
 
 	if errors.Is(err, sql.ErrNoRows) {
-		logs.PrintLog(ctx, "[repository] GetUserBySystemId", err.Error())
+		logs.PrintLog(ctx, "[repository] SetUserTags", err.Error())
 		return nil, nil
 	}
 
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] GetUserBySystemId", err.Error())
+		logs.PrintLog(ctx, "[repository] SetUserTags", err.Error())
 		return nil, err
 	}
+
 	return &user, nil
 }
 
-func (db *Database) GetListReviewsByUserId(ctx context.Context, userId int) ([]*models.PullRequest, error) {
-	const prQuery = `
-        SELECT
-            pr.system_id,
-            pr.pull_request_name,
-            au.system_id,
-            pr.status
-        FROM pull_request_reviewers AS r
-        JOIN pull_requests AS pr ON pr.pull_request_id = r.pull_request_id
-        JOIN users AS au ON au.user_id = pr.author_id
-        WHERE r.user_id = $1;
+func (db *Database) SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error) {
+	query := `
+        UPDATE users AS u
+        SET max_open_reviews = $2
+        WHERE u.system_id = $1
+        RETURNING
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            COALESCE(u.team_id, 0),
+            COALESCE((SELECT team_name FROM teams WHERE team_id = u.team_id), ''),
+            u.is_active,
+            u.tags,
+            u.max_open_reviews,
+            ` + openReviewsNow("$3") + `;
     `
 
-	rows, err := db.conn.QueryContext(ctx, prQuery, userId)
-	if err != nil {
-		logs.PrintLog(ctx, "[repository] GetListReviewsByUserId", err.Error())
-		return nil, err
-	}
-	defer func() {
+	var user models.User
+	err := db.conn.
+		QueryRowContext(ctx, query, userID, maxOpenReviews, models.StatusOpen).
+		Scan(
+			&user.UserId,
+			&user.SystemId,
+			&user.UserName,
+			&user.TeamId,
+			&user.TeamName,
+			&user.IsActive,
+			pq.Array(&user.Tags),
+			&user.MaxOpenReviews,
+			&user.OpenReviews,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		logs.PrintLog(ctx, "[repository] SetUserMaxOpenReviews", err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetUserMaxOpenReviews", err.Error())
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (db *Database) SetUserContacts(ctx context.Context, contact *models.Contact) error {
+	const query = `
+        INSERT INTO user_contacts (user_id, slack_webhook_url, email)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (user_id) DO UPDATE
+        SET slack_webhook_url = EXCLUDED.slack_webhook_url,
+            email = EXCLUDED.email;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, contact.UserId, contact.SlackWebhookUrl, contact.Email); err != nil {
+		logs.PrintLog(ctx, "[repository] SetUserContacts", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetUserContacts returns a contact for every known user of userIds, with
+// empty channels for users who never set them.
+func (db *Database) GetUserContacts(ctx context.Context, userIds []int) ([]*models.Contact, error) {
+	const query = `
+        SELECT
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            COALESCE(c.slack_webhook_url, ''),
+            COALESCE(c.email, '')
+        FROM users AS u
+        LEFT JOIN user_contacts AS c ON c.user_id = u.user_id
+        WHERE u.user_id = ANY($1)
+        ORDER BY u.user_id;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, pq.Array(userIds))
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetUserContacts", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	contacts := make([]*models.Contact, 0, len(userIds))
+	for rows.Next() {
+		var c models.Contact
+		if err := rows.Scan(&c.UserId, &c.SystemId, &c.UserName, &c.SlackWebhookUrl, &c.Email); err != nil {
+			logs.PrintLog(ctx, "[repository] GetUserContacts", err.Error())
+			return nil, err
+		}
+
+		contacts = append(contacts, &c)
+	}
+
+	return contacts, nil
+}
+
+func (db *Database) AddUnavailability(ctx context.Context, period *models.Unavailability) error {
+	const query = `
+        INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+        VALUES ($1, $2, $3, $4)
+        RETURNING period_id;
+    `
+
+	err := db.conn.QueryRowContext(ctx, query, period.User.UserId, period.StartsAt, period.EndsAt, period.Reason).
+		Scan(&period.PeriodId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] AddUnavailability", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetUserUnavailability(ctx context.Context, userId int) ([]*models.Unavailability, error) {
+	const query = `
+        SELECT
+            v.period_id,
+            u.user_id,
+            u.system_id,
+            v.starts_at,
+            v.ends_at,
+            v.reason
+        FROM user_unavailability AS v
+        JOIN users AS u ON u.user_id = v.user_id
+        WHERE v.user_id = $1
+        ORDER BY v.starts_at;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, userId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetUserUnavailability", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	periods := make([]*models.Unavailability, 0)
+	for rows.Next() {
+		p := &models.Unavailability{User: &models.User{}}
+
+		err := rows.Scan(&p.PeriodId, &p.User.UserId, &p.User.SystemId, &p.StartsAt, &p.EndsAt, &p.Reason)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetUserUnavailability", err.Error())
+			return nil, err
+		}
+
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+}
+
+func (db *Database) DeleteUnavailability(ctx context.Context, periodId int) (bool, error) {
+	const query = `
+        DELETE FROM user_unavailability
+        WHERE period_id = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, periodId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteUnavailability", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteUnavailability", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetStartedUnavailability returns current periods whose reviews were not
+// moved yet, with the users they belong to.
+func (db *Database) GetStartedUnavailability(ctx context.Context) ([]*models.Unavailability, error) {
+	const query = `
+        SELECT
+            v.period_id,
+            v.starts_at,
+            v.ends_at,
+            v.reason,
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            COALESCE(u.team_id, 0),
+            COALESCE(t.team_name, ''),
+            u.is_active,
+            u.review_weight
+        FROM user_unavailability AS v
+        JOIN users AS u ON u.user_id = v.user_id
+        LEFT JOIN teams AS t ON t.team_id = u.team_id
+        WHERE v.handled_at IS NULL AND v.starts_at <= NOW() AND v.ends_at > NOW()
+        ORDER BY v.starts_at;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetStartedUnavailability", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	periods := make([]*models.Unavailability, 0)
+	for rows.Next() {
+		p := &models.Unavailability{User: &models.User{Unavailable: true}}
+
+		err := rows.Scan(
+			&p.PeriodId,
+			&p.StartsAt,
+			&p.EndsAt,
+			&p.Reason,
+			&p.User.UserId,
+			&p.User.SystemId,
+			&p.User.UserName,
+			&p.User.TeamId,
+			&p.User.TeamName,
+			&p.User.IsActive,
+			&p.User.ReviewWeight,
+		)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetStartedUnavailability", err.Error())
+			return nil, err
+		}
+
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+}
+
+func (db *Database) MarkUnavailabilityHandled(ctx context.Context, periodId int) error {
+	const query = `
+        UPDATE user_unavailability
+        SET handled_at = NOW()
+        WHERE period_id = $1;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, periodId); err != nil {
+		logs.PrintLog(ctx, "[repository] MarkUnavailabilityHandled", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) DeactivateUsers(ctx context.Context, userIds []int, changes []*models.ReviewerChange, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeactivateUsers", err.Error())
+		return err
+	}
+
+	const query = `
+        UPDATE users
+        SET is_active = FALSE
+        WHERE user_id = ANY($1);
+    `
+
+	if _, err := tx.ExecContext(ctx, query, pq.Array(userIds)); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeactivateUsers", err.Error())
+		return err
+	}
+
+	if err := applyReviewerChanges(ctx, tx, changes); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeactivateUsers", err.Error())
+		return err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeactivateUsers", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] DeactivateUsers", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetUserBySystemId(ctx context.Context, systemId string) (*models.User, error) {
+	userQuery := `
+        SELECT 
+            u.user_id,
+            u.user_name,
+            COALESCE(u.team_id, 0),
+            COALESCE(t.team_name, ''),
+            u.is_active,
+            u.review_weight,
+            u.tags,
+            ` + unavailableNow + `
+        FROM users AS u
+        LEFT JOIN teams AS t ON t.team_id = u.team_id
+        WHERE u.system_id = $1;
+    `
+
+	var user models.User
+	user.SystemId = systemId
+	err := db.conn.QueryRowContext(ctx, userQuery, systemId).
+		Scan(&user.UserId, &user.UserName, &user.TeamId, &user.TeamName, &user.IsActive, &user.ReviewWeight, pq.Array(&user.Tags), &user.Unavailable)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		logs.PrintLog(ctx, "[repository] GetUserBySystemId", err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetUserBySystemId", err.Error())
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (db *Database) GetListReviewsByUserId(ctx context.Context, userId int) ([]*models.PullRequest, error) {
+	const prQuery = `
+        SELECT
+            pr.system_id,
+            pr.pull_request_name,
+            au.system_id,
+            pr.status,
+            r.decision,
+            r.decided_at
+        FROM pull_request_reviewers AS r
+        JOIN pull_requests AS pr ON pr.pull_request_id = r.pull_request_id
+        JOIN users AS au ON au.user_id = pr.author_id
+        WHERE r.user_id = $1;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, prQuery, userId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetListReviewsByUserId", err.Error())
+		return nil, err
+	}
+	defer func() {
 		_ = rows.Close()
 	}()
 
 	reviews := make([]*models.PullRequest, 0)
 	for rows.Next() {
-		pr := &models.PullRequest{}
+		pr := &models.PullRequest{Decisions: make(map[int]*models.ReviewDecision)}
+
+		var decision sql.NullString
+		var decidedAt sql.NullTime
 
 		err := rows.Scan(
 			&pr.SystemId,
 			&pr.PullRequestName,
 			&pr.AuthorSystemId,
 			&pr.Status,
+			&decision,
+			&decidedAt,
 		)
 		if err != nil {
 			logs.PrintLog(ctx, "[repository] GetListReviewsByUserId", err.Error())
 			return nil, err
 		}
 
-		reviews = append(reviews, pr)
+		if decision.Valid {
+			pr.Decisions[userId] = &models.ReviewDecision{Decision: decision.String, DecidedAt: decidedAt.Time}
+		}
+
+		reviews = append(reviews, pr)
+	}
+	return reviews, nil
+}
+
+func (db *Database) PullRequestExists(ctx context.Context, prSystemID string) (bool, error) {
+	const query = `
+        SELECT EXISTS (
+            SELECT 1 
+            FROM pull_requests
+            WHERE system_id = $1
+        );
+    `
+
+	var exists bool
+	err := db.conn.QueryRowContext(ctx, query, prSystemID).Scan(&exists)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] PullRequestExists", err.Error())
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (db *Database) GetTeamMembers(ctx context.Context, teamId int) ([]*models.User, error) {
+	query := `
+        SELECT 
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            u.is_active,
+            u.review_weight,
+            u.tags,
+            ` + unavailableNow + `,
+            u.max_open_reviews,
+            ` + openReviewsNow("$2") + `,
+            t.team_name
+        FROM users AS u
+        JOIN teams AS t ON t.team_id = u.team_id
+        WHERE u.team_id = $1;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, teamId, models.StatusOpen)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetTeamMembers", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	members := make([]*models.User, 0)
+
+	for rows.Next() {
+		m := &models.User{TeamId: teamId}
+
+		err := rows.Scan(
+			&m.UserId,
+			&m.SystemId,
+			&m.UserName,
+			&m.IsActive,
+			&m.ReviewWeight,
+			pq.Array(&m.Tags),
+			&m.Unavailable,
+			&m.MaxOpenReviews,
+			&m.OpenReviews,
+			&m.TeamName,
+		)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetTeamMembers", err.Error())
+			return nil, err
+		}
+
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// GetPartnerTeams returns partner teams in the order they are asked for reviewers.
+func (db *Database) GetPartnerTeams(ctx context.Context, teamId int) ([]*models.Team, error) {
+	const query = `
+        SELECT
+            t.team_id,
+            t.team_name,
+            t.reviewer_strategy,
+            t.min_reviewers,
+            t.max_reviewers,
+            t.pairing_lookback_days
+        FROM team_partners AS p
+        JOIN teams AS t ON t.team_id = p.partner_team_id
+        WHERE p.team_id = $1
+        ORDER BY p.priority;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, teamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetPartnerTeams", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	teams := make([]*models.Team, 0)
+	for rows.Next() {
+		t := &models.Team{}
+
+		err := rows.Scan(
+			&t.TeamId,
+			&t.TeamName,
+			&t.ReviewerStrategy,
+			&t.MinReviewers,
+			&t.MaxReviewers,
+			&t.PairingLookbackDays,
+		)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetPartnerTeams", err.Error())
+			return nil, err
+		}
+
+		teams = append(teams, t)
+	}
+
+	return teams, nil
+}
+
+// SetTeamPartners replaces the partner list; the position in partnerIds is
+// the priority.
+func (db *Database) SetTeamPartners(ctx context.Context, teamId int, partnerIds []int) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamPartners", err.Error())
+		return err
+	}
+
+	const deleteQuery = `
+        DELETE FROM team_partners
+        WHERE team_id = $1;
+    `
+
+	if _, err := tx.ExecContext(ctx, deleteQuery, teamId); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetTeamPartners", err.Error())
+		return err
+	}
+
+	const insertQuery = `
+        INSERT INTO team_partners (team_id, partner_team_id, priority)
+        VALUES ($1, $2, $3);
+    `
+
+	for i, partnerId := range partnerIds {
+		if _, err := tx.ExecContext(ctx, insertQuery, teamId, partnerId, i); err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] SetTeamPartners", err.Error())
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] SetTeamPartners", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// selectOwnershipRules binds models.StatusOpen to $2, the queries using it
+// filter on $1.
+var selectOwnershipRules = `
+        SELECT
+            r.rule_id,
+            r.team_id,
+            r.pattern,
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            COALESCE(u.team_id, 0),
+            u.is_active,
+            u.review_weight,
+            ` + unavailableNow + `,
+            u.max_open_reviews,
+            ` + openReviewsNow("$2") + `
+        FROM ownership_rules AS r
+        LEFT JOIN ownership_rule_owners AS o ON o.rule_id = r.rule_id
+        LEFT JOIN users AS u ON u.user_id = o.user_id
+    `
+
+// scanOwnershipRules folds rows of selectOwnershipRules ordered by rule into
+// rules with their owners.
+func scanOwnershipRules(rows *sql.Rows) ([]*models.OwnershipRule, error) {
+	rules := make([]*models.OwnershipRule, 0)
+
+	for rows.Next() {
+		var rule models.OwnershipRule
+		var userId, teamId, weight sql.NullInt64
+		var systemId, userName sql.NullString
+		var isActive sql.NullBool
+		var unavailable bool
+		var maxOpenReviews *int
+		var openReviews int
+
+		err := rows.Scan(
+			&rule.RuleId,
+			&rule.TeamId,
+			&rule.Pattern,
+			&userId,
+			&systemId,
+			&userName,
+			&teamId,
+			&isActive,
+			&weight,
+			&unavailable,
+			&maxOpenReviews,
+			&openReviews,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rules) == 0 || rules[len(rules)-1].RuleId != rule.RuleId {
+			rule.Owners = make([]*models.User, 0)
+			rules = append(rules, &rule)
+		}
+
+		if userId.Valid {
+			last := rules[len(rules)-1]
+			last.Owners = append(last.Owners, &models.User{
+				UserId:         int(userId.Int64),
+				SystemId:       systemId.String,
+				UserName:       userName.String,
+				TeamId:         int(teamId.Int64),
+				IsActive:       isActive.Bool,
+				ReviewWeight:   int(weight.Int64),
+				Unavailable:    unavailable,
+				MaxOpenReviews: maxOpenReviews,
+				OpenReviews:    openReviews,
+			})
+		}
+	}
+
+	return rules, nil
+}
+
+// GetOwnershipRules returns the rules of a team in the order they are applied.
+func (db *Database) GetOwnershipRules(ctx context.Context, teamId int) ([]*models.OwnershipRule, error) {
+	query := selectOwnershipRules + `
+        WHERE r.team_id = $1
+        ORDER BY r.rule_id, o.position;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, teamId, models.StatusOpen)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetOwnershipRules", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	rules, err := scanOwnershipRules(rows)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetOwnershipRules", err.Error())
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (db *Database) GetOwnershipRuleById(ctx context.Context, ruleId int) (*models.OwnershipRule, error) {
+	query := selectOwnershipRules + `
+        WHERE r.rule_id = $1
+        ORDER BY o.position;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, ruleId, models.StatusOpen)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetOwnershipRuleById", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	rules, err := scanOwnershipRules(rows)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetOwnershipRuleById", err.Error())
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	return rules[0], nil
+}
+
+func insertRuleOwners(ctx context.Context, tx *sql.Tx, rule *models.OwnershipRule) error {
+	const query = `
+        INSERT INTO ownership_rule_owners (rule_id, user_id, position)
+        VALUES ($1, $2, $3)
+        ON CONFLICT DO NOTHING;
+    `
+
+	for i, owner := range rule.Owners {
+		if _, err := tx.ExecContext(ctx, query, rule.RuleId, owner.UserId, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *Database) CreateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] CreateOwnershipRule", err.Error())
+		return err
+	}
+
+	const insertRule = `
+        INSERT INTO ownership_rules (team_id, pattern)
+        VALUES ($1, $2)
+        RETURNING rule_id;
+    `
+
+	if err := tx.QueryRowContext(ctx, insertRule, rule.TeamId, rule.Pattern).Scan(&rule.RuleId); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] CreateOwnershipRule", err.Error())
+		return err
+	}
+
+	if err := insertRuleOwners(ctx, tx, rule); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] CreateOwnershipRule", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] CreateOwnershipRule", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateOwnershipRule replaces the pattern and owners of a rule; the rule
+// keeps its position among the team rules.
+func (db *Database) UpdateOwnershipRule(ctx context.Context, rule *models.OwnershipRule) (bool, error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		return false, err
+	}
+
+	const updateRule = `
+        UPDATE ownership_rules
+        SET pattern = $2
+        WHERE rule_id = $1;
+    `
+
+	result, err := tx.ExecContext(ctx, updateRule, rule.RuleId, rule.Pattern)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		_ = tx.Rollback()
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		}
+		return false, err
+	}
+
+	const deleteOwners = `
+        DELETE FROM ownership_rule_owners
+        WHERE rule_id = $1;
+    `
+
+	if _, err := tx.ExecContext(ctx, deleteOwners, rule.RuleId); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		return false, err
+	}
+
+	if err := insertRuleOwners(ctx, tx, rule); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateOwnershipRule", err.Error())
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (db *Database) DeleteOwnershipRule(ctx context.Context, ruleId int) (bool, error) {
+	const query = `
+        DELETE FROM ownership_rules
+        WHERE rule_id = $1;
+    `
+
+	result, err := db.conn.ExecContext(ctx, query, ruleId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteOwnershipRule", err.Error())
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteOwnershipRule", err.Error())
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db *Database) GetOpenReviewCounts(ctx context.Context, userIds []int) (map[int]int, error) {
+	const query = `
+        SELECT
+            r.user_id,
+            COUNT(*)
+        FROM pull_request_reviewers AS r
+        JOIN pull_requests AS pr ON pr.pull_request_id = r.pull_request_id
+        WHERE r.user_id = ANY($1) AND pr.status = $2
+        GROUP BY r.user_id;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, pq.Array(userIds), models.StatusOpen)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetOpenReviewCounts", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	counts := make(map[int]int, len(userIds))
+	for rows.Next() {
+		var userId, count int
+		if err := rows.Scan(&userId, &count); err != nil {
+			logs.PrintLog(ctx, "[repository] GetOpenReviewCounts", err.Error())
+			return nil, err
+		}
+
+		counts[userId] = count
+	}
+
+	return counts, nil
+}
+
+// GetPairings counts pull requests of the author created since the given time
+// that each of the users was assigned to review.
+func (db *Database) GetPairings(ctx context.Context, authorId int, userIds []int, since time.Time) (map[int]models.Pairing, error) {
+	const query = `
+        SELECT
+            r.user_id,
+            COUNT(*),
+            MAX(pr.created_at)
+        FROM pull_request_reviewers AS r
+        JOIN pull_requests AS pr ON pr.pull_request_id = r.pull_request_id
+        WHERE pr.author_id = $1 AND r.user_id = ANY($2) AND pr.created_at >= $3
+        GROUP BY r.user_id;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query, authorId, pq.Array(userIds), since)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetPairings", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	pairings := make(map[int]models.Pairing, len(userIds))
+	for rows.Next() {
+		var userId int
+		var p models.Pairing
+		if err := rows.Scan(&userId, &p.Reviews, &p.LastAt); err != nil {
+			logs.PrintLog(ctx, "[repository] GetPairings", err.Error())
+			return nil, err
+		}
+
+		pairings[userId] = p
+	}
+
+	return pairings, nil
+}
+
+// CreatePullRequestAndReview stores events with the id of the created PR.
+func (db *Database) CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviewers []*models.User, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		return err
+	}
+
+	const insertPR = `
+        INSERT INTO pull_requests (system_id, pull_request_name, author_id, author_team_id, status, changed_files, required_tags)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING pull_request_id;
+    `
+
+	err = tx.QueryRowContext(
+		ctx,
+		insertPR,
+		pr.SystemId,
+		pr.PullRequestName,
+		pr.AuthorId,
+		pr.AuthorTeamId,
+		pr.Status,
+		pq.Array(pr.ChangedFiles),
+		pq.Array(pr.RequiredTags),
+	).Scan(&pr.PullRequestId)
+
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		return err
+	}
+
+	const insertReviewer = `
+        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	for _, r := range reviewers {
+		_, err := tx.ExecContext(ctx, insertReviewer, pr.PullRequestId, r.UserId)
+		if err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+			return err
+		}
+	}
+
+	for _, e := range events {
+		e.PullRequestId = pr.PullRequestId
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetPullRequestById(ctx context.Context, prSystemId string) (*models.PullRequest, error) {
+	const prQuery = `
+        SELECT 
+            pr.pull_request_id,
+            pr.system_id,
+            pr.pull_request_name,
+            pr.author_id,
+            au.system_id AS author_system_id,
+            COALESCE(pr.author_team_id, au.team_id, 0) AS author_team_id,
+            pr.status,
+            pr.created_at,
+            pr.merged_at,
+            pr.changed_files,
+            pr.required_tags
+        FROM pull_requests AS pr
+        JOIN users AS au ON au.user_id = pr.author_id
+        WHERE pr.system_id = $1;
+    `
+
+	pr := &models.PullRequest{}
+	err := db.conn.QueryRowContext(ctx, prQuery, prSystemId).Scan(
+		&pr.PullRequestId,
+		&pr.SystemId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.AuthorSystemId,
+		&pr.AuthorTeamId,
+		&pr.Status,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+		pq.Array(&pr.ChangedFiles),
+		pq.Array(&pr.RequiredTags),
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+		return nil, err
+	}
+
+	const reviewersQuery = `
+        SELECT 
+            u.user_id,
+            u.system_id,
+            u.user_name,
+            r.decision,
+            r.decided_at
+        FROM pull_request_reviewers AS r
+        JOIN users AS u ON u.user_id = r.user_id
+        WHERE r.pull_request_id = $1;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, reviewersQuery, pr.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	pr.AssigneeReviewers = make([]*models.User, 0)
+	pr.Decisions = make(map[int]*models.ReviewDecision)
+
+	for rows.Next() {
+		u := &models.User{}
+
+		var decision sql.NullString
+		var decidedAt sql.NullTime
+
+		err := rows.Scan(
+			&u.UserId,
+			&u.SystemId,
+			&u.UserName,
+			&decision,
+			&decidedAt,
+		)
+
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+			return nil, err
+		}
+
+		if decision.Valid {
+			pr.Decisions[u.UserId] = &models.ReviewDecision{Decision: decision.String, DecidedAt: decidedAt.Time}
+		}
+
+		pr.AssigneeReviewers = append(pr.AssigneeReviewers, u)
+	}
+
+	return pr, nil
+}
+
+func (db *Database) SetMergedStatusPullRequest(ctx context.Context, prId int, events []*models.PrEvent) (sql.NullTime, error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetMergedStatusPullRequest", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	const query = `
+        UPDATE pull_requests
+        SET 
+            status = $2,
+            merged_at = NOW()
+        WHERE pull_request_id = $1
+        RETURNING merged_at;
+    `
+
+	var mergedAt sql.NullTime
+
+	err = tx.QueryRowContext(ctx, query, prId, models.StatusMerged).Scan(&mergedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetMergedStatusPullRequest", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetMergedStatusPullRequest", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] SetMergedStatusPullRequest", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	return mergedAt, nil
+}
+
+// SetPullRequestStatus changes the status and assigns reviewers in one go,
+// so a PR never becomes OPEN without the reviewers picked for it.
+func (db *Database) SetPullRequestStatus(ctx context.Context, prId int, status string, reviewers []*models.User, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetPullRequestStatus", err.Error())
+		return err
+	}
+
+	const query = `
+        UPDATE pull_requests
+        SET status = $2
+        WHERE pull_request_id = $1;
+    `
+
+	_, err = tx.ExecContext(ctx, query, prId, status)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetPullRequestStatus", err.Error())
+		return err
+	}
+
+	const insertReviewer = `
+        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	for _, r := range reviewers {
+		_, err := tx.ExecContext(ctx, insertReviewer, prId, r.UserId)
+		if err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] SetPullRequestStatus", err.Error())
+			return err
+		}
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetPullRequestStatus", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] SetPullRequestStatus", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// SetReviewDecision overwrites the previous decision of the reviewer. The
+// returned time is not valid when the reviewer is not assigned to the PR.
+func (db *Database) SetReviewDecision(ctx context.Context, prId int, userId int, decision string, events []*models.PrEvent) (sql.NullTime, error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] SetReviewDecision", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	const query = `
+        UPDATE pull_request_reviewers
+        SET
+            decision = $3,
+            decided_at = NOW()
+        WHERE pull_request_id = $1 AND user_id = $2
+        RETURNING decided_at;
+    `
+
+	var decidedAt sql.NullTime
+
+	err = tx.QueryRowContext(ctx, query, prId, userId, decision).Scan(&decidedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetReviewDecision", err.Error())
+		return sql.NullTime{}, nil
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetReviewDecision", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] SetReviewDecision", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] SetReviewDecision", err.Error())
+		return sql.NullTime{}, err
+	}
+
+	return decidedAt, nil
+}
+
+func (db *Database) ReplaceReviewers(ctx context.Context, prId int, oldReviewerId int, newReviewerId int, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	const deleteQuery = `
+        DELETE FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND user_id = $2;
+    `
+
+	result, err := tx.ExecContext(ctx, deleteQuery, prId, oldReviewerId)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	_, err = result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	const insertQuery = `
+        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	result, err = tx.ExecContext(ctx, insertQuery, prId, newReviewerId)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	_, err = result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		return err
+	}
+
+	logs.PrintLog(ctx, "[repository] ReplaceReviewers", fmt.Sprintf("success replace: %+v -> %+v", oldReviewerId, newReviewerId))
+	return nil
+}
+
+func (db *Database) DeleteReview(ctx context.Context, prId int, userId int, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		return err
+	}
+
+	const query = `
+        DELETE FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND user_id = $2;
+    `
+
+	result, err := tx.ExecContext(ctx, query, prId, userId)
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		return fmt.Errorf("delete reviewer: %w", err)
+	}
+
+	_, err = result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		return err
+	}
+
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) UpdateReviewers(ctx context.Context, prId int, added []*models.User, removed []*models.User, events []*models.PrEvent) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateReviewers", err.Error())
+		return err
+	}
+
+	const deleteQuery = `
+        DELETE FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND user_id = $2;
+    `
+
+	for _, r := range removed {
+		if _, err := tx.ExecContext(ctx, deleteQuery, prId, r.UserId); err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] UpdateReviewers", err.Error())
+			return err
+		}
+	}
+
+	const insertQuery = `
+        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	for _, r := range added {
+		if _, err := tx.ExecContext(ctx, insertQuery, prId, r.UserId); err != nil {
+			_ = tx.Rollback()
+			logs.PrintLog(ctx, "[repository] UpdateReviewers", err.Error())
+			return err
+		}
 	}
-	return reviews, nil
-}
 
-func (db *Database) PullRequestExists(ctx context.Context, prSystemID string) (bool, error) {
-	const query = `
-        SELECT EXISTS (
-            SELECT 1 
-            FROM pull_requests
-            WHERE system_id = $1
-        );
-    `
+	if err := insertEvents(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		logs.PrintLog(ctx, "[repository] UpdateReviewers", err.Error())
+		return err
+	}
 
-	var exists bool
-	err := db.conn.QueryRowContext(ctx, query, prSystemID).Scan(&exists)
-	if err != nil {
-		logs.PrintLog(ctx, "[repository] PullRequestExists", err.Error())
-		return false, err
+	if err := tx.Commit(); err != nil {
+		logs.PrintLog(ctx, "[repository] UpdateReviewers", err.Error())
+		return err
 	}
 
-	return exists, nil
+	return nil
 }
 
-func (db *Database) GetTeamMembers(ctx context.Context, teamId int) ([]*models.User, error) {
+func (db *Database) GetPullRequestEvents(ctx context.Context, prId int) ([]*models.PrEvent, error) {
 	const query = `
-        SELECT 
-            user_id,
-            system_id,
-            user_name,
-            is_active
-        FROM users
-        WHERE team_id = $1;
+        SELECT
+            e.event_id,
+            e.pull_request_id,
+            e.event_type,
+            COALESCE(e.actor, ''),
+            COALESCE(e.old_reviewer_id, 0),
+            COALESCE(ou.system_id, ''),
+            COALESCE(e.new_reviewer_id, 0),
+            COALESCE(nu.system_id, ''),
+            e.reason,
+            e.selection_seed,
+            e.created_at
+        FROM pr_events AS e
+        LEFT JOIN users AS ou ON ou.user_id = e.old_reviewer_id
+        LEFT JOIN users AS nu ON nu.user_id = e.new_reviewer_id
+        WHERE e.pull_request_id = $1
+        ORDER BY e.event_id;
     `
 
-	rows, err := db.conn.QueryContext(ctx, query, teamId)
+	rows, err := db.conn.QueryContext(ctx, query, prId)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] GetTeamMembers", err.Error())
+		logs.PrintLog(ctx, "[repository] GetPullRequestEvents", err.Error())
 		return nil, err
 	}
 	defer func() {
 		_ = rows.Close()
 	}()
 
-	members := make([]*models.User, 0)
-
+	events := make([]*models.PrEvent, 0)
 	for rows.Next() {
-		m := &models.User{}
+		e := &models.PrEvent{}
 
 		err := rows.Scan(
-			&m.UserId,
-			&m.SystemId,
-			&m.UserName,
-			&m.IsActive,
+			&e.EventId,
+			&e.PullRequestId,
+			&e.EventType,
+			&e.Actor,
+			&e.OldReviewerId,
+			&e.OldReviewerSystemId,
+			&e.NewReviewerId,
+			&e.NewReviewerSystemId,
+			&e.Reason,
+			&e.Seed,
+			&e.CreatedAt,
 		)
 		if err != nil {
-			logs.PrintLog(ctx, "[repository] GetTeamMembers", err.Error())
+			logs.PrintLog(ctx, "[repository] GetPullRequestEvents", err.Error())
 			return nil, err
 		}
 
-		members = append(members, m)
+		events = append(events, e)
 	}
 
-	return members, nil
+	return events, nil
 }
 
-func (db *Database) CreatePullRequestAndReview(ctx context.Context, pr *models.PullRequest, reviewers []*models.User) error {
+func (db *Database) AddAssignmentExplanation(ctx context.Context, explanation *models.AssignmentExplanation) error {
 	tx, err := db.conn.BeginTx(ctx, nil)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		logs.PrintLog(ctx, "[repository] AddAssignmentExplanation", err.Error())
 		return err
 	}
 
-	const insertPR = `
-        INSERT INTO pull_requests (system_id, pull_request_name, author_id, status)
-        VALUES ($1, $2, $3, $4)
-        RETURNING pull_request_id;
+	const insertExplanation = `
+        INSERT INTO assignment_explanations (pull_request_id, trigger_type, actor, strategy, selection_seed, replaced_reviewer_id)
+        VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, 0))
+        RETURNING explanation_id, created_at;
     `
 
 	err = tx.QueryRowContext(
 		ctx,
-		insertPR,
-		pr.SystemId,
-		pr.PullRequestName,
-		pr.AuthorId,
-		pr.Status,
-	).Scan(&pr.PullRequestId)
+		insertExplanation,
+		explanation.PullRequestId,
+		explanation.Trigger,
+		explanation.Actor,
+		explanation.Strategy,
+		explanation.Seed,
+		explanation.ReplacedReviewerId,
+	).Scan(&explanation.ExplanationId, &explanation.CreatedAt)
 
 	if err != nil {
 		_ = tx.Rollback()
-		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		logs.PrintLog(ctx, "[repository] AddAssignmentExplanation", err.Error())
 		return err
 	}
 
-	const insertReviewer = `
-        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
-        VALUES ($1, $2)
+	const insertCandidate = `
+        INSERT INTO assignment_candidates (explanation_id, user_id, position, excluded_reason, selected, pool)
+        VALUES ($1, $2, $3, NULLIF($4, ''), $5, NULLIF($6, ''))
         ON CONFLICT DO NOTHING;
     `
 
-	for _, r := range reviewers {
-		_, err := tx.ExecContext(ctx, insertReviewer, pr.PullRequestId, r.UserId)
+	for i, c := range explanation.Candidates {
+		_, err := tx.ExecContext(ctx, insertCandidate, explanation.ExplanationId, c.UserId, i, c.ExcludedReason, c.Selected, c.Pool)
 		if err != nil {
 			_ = tx.Rollback()
-			logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+			logs.PrintLog(ctx, "[repository] AddAssignmentExplanation", err.Error())
 			return err
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		logs.PrintLog(ctx, "[repository] CreatePullRequestAndReview", err.Error())
+		logs.PrintLog(ctx, "[repository] AddAssignmentExplanation", err.Error())
 		return err
 	}
 
 	return nil
 }
 
-func (db *Database) GetPullRequestById(ctx context.Context, prSystemId string) (*models.PullRequest, error) {
-	const prQuery = `
-        SELECT 
-            pr.pull_request_id,
-            pr.system_id,
-            pr.pull_request_name,
-            pr.author_id,
-            au.system_id AS author_system_id,
-            pr.status,
-            pr.created_at,
-            pr.merged_at
-        FROM pull_requests AS pr
-        JOIN users AS au ON au.user_id = pr.author_id
-        WHERE pr.system_id = $1;
+// GetAssignmentExplanations returns explanations of a pull request oldest
+// first, candidates in the order the selection looked at them.
+func (db *Database) GetAssignmentExplanations(ctx context.Context, prId int) ([]*models.AssignmentExplanation, error) {
+	const query = `
+        SELECT
+            e.explanation_id,
+            e.pull_request_id,
+            e.trigger_type,
+            COALESCE(e.actor, ''),
+            e.strategy,
+            e.selection_seed,
+            COALESCE(e.replaced_reviewer_id, 0),
+            COALESCE(ru.system_id, ''),
+            e.created_at,
+            c.user_id,
+            cu.system_id,
+            c.excluded_reason,
+            c.selected,
+            c.pool
+        FROM assignment_explanations AS e
+        LEFT JOIN users AS ru ON ru.user_id = e.replaced_reviewer_id
+        LEFT JOIN assignment_candidates AS c ON c.explanation_id = e.explanation_id
+        LEFT JOIN users AS cu ON cu.user_id = c.user_id
+        WHERE e.pull_request_id = $1
+        ORDER BY e.explanation_id, c.position;
     `
 
-	pr := &models.PullRequest{}
-	err := db.conn.QueryRowContext(ctx, prQuery, prSystemId).Scan(
-		&pr.PullRequestId,
-		&pr.SystemId,
-		&pr.PullRequestName,
-		&pr.AuthorId,
-		&pr.AuthorSystemId,
-		&pr.Status,
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
-
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, nil
-	}
-
+	rows, err := db.conn.QueryContext(ctx, query, prId)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+		logs.PrintLog(ctx, "[repository] GetAssignmentExplanations", err.Error())
 		return nil, err
 	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	explanations := make([]*models.AssignmentExplanation, 0)
+	for rows.Next() {
+		var e models.AssignmentExplanation
+		var userId sql.NullInt64
+		var systemId, reason, pool sql.NullString
+		var selected sql.NullBool
+
+		err := rows.Scan(
+			&e.ExplanationId,
+			&e.PullRequestId,
+			&e.Trigger,
+			&e.Actor,
+			&e.Strategy,
+			&e.Seed,
+			&e.ReplacedReviewerId,
+			&e.ReplacedReviewerSystemId,
+			&e.CreatedAt,
+			&userId,
+			&systemId,
+			&reason,
+			&selected,
+			&pool,
+		)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetAssignmentExplanations", err.Error())
+			return nil, err
+		}
+
+		if len(explanations) == 0 || explanations[len(explanations)-1].ExplanationId != e.ExplanationId {
+			e.Candidates = make([]*models.AssignmentCandidate, 0)
+			explanations = append(explanations, &e)
+		}
+
+		if userId.Valid {
+			last := explanations[len(explanations)-1]
+			last.Candidates = append(last.Candidates, &models.AssignmentCandidate{
+				UserId:         int(userId.Int64),
+				SystemId:       systemId.String,
+				ExcludedReason: reason.String,
+				Selected:       selected.Bool,
+				Pool:           pool.String,
+			})
+		}
+	}
 
-	const reviewersQuery = `
-        SELECT 
-            u.user_id,
+	return explanations, nil
+}
+
+// GetReviewerStats counts reviews per user. Assigned and open reviews are
+// filtered by PR creation time, merged ones by merge time; an invalid bound
+// means the window is open on that side.
+func (db *Database) GetReviewerStats(ctx context.Context, from sql.NullTime, to sql.NullTime, teamName string) ([]*models.ReviewerStats, error) {
+	const query = `
+        SELECT
             u.system_id,
-            u.user_name
-        FROM pull_request_reviewers AS r
-        JOIN users AS u ON u.user_id = r.user_id
-        WHERE r.pull_request_id = $1;
+            u.user_name,
+            COALESCE(t.team_name, ''),
+            COUNT(pr.pull_request_id) FILTER (
+                WHERE ($1::timestamp IS NULL OR pr.created_at >= $1)
+                  AND ($2::timestamp IS NULL OR pr.created_at < $2)
+            ) AS assigned,
+            COUNT(pr.pull_request_id) FILTER (
+                WHERE pr.status = $4
+                  AND ($1::timestamp IS NULL OR pr.created_at >= $1)
+                  AND ($2::timestamp IS NULL OR pr.created_at < $2)
+            ) AS open,
+            COUNT(pr.pull_request_id) FILTER (
+                WHERE pr.status = $5
+                  AND ($1::timestamp IS NULL OR pr.merged_at >= $1)
+                  AND ($2::timestamp IS NULL OR pr.merged_at < $2)
+            ) AS merged
+        FROM users AS u
+        LEFT JOIN teams AS t ON t.team_id = u.team_id
+        LEFT JOIN pull_request_reviewers AS r ON r.user_id = u.user_id
+        LEFT JOIN pull_requests AS pr ON pr.pull_request_id = r.pull_request_id
+        WHERE $3 = '' OR t.team_name = $3
+        GROUP BY u.user_id, u.system_id, u.user_name, t.team_name
+        ORDER BY t.team_name, u.system_id;
     `
 
-	rows, err := db.conn.QueryContext(ctx, reviewersQuery, pr.PullRequestId)
+	rows, err := db.conn.QueryContext(ctx, query, from, to, teamName, models.StatusOpen, models.StatusMerged)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+		logs.PrintLog(ctx, "[repository] GetReviewerStats", err.Error())
 		return nil, err
 	}
 	defer func() {
 		_ = rows.Close()
 	}()
 
-	pr.AssigneeReviewers = make([]*models.User, 0)
-
+	stats := make([]*models.ReviewerStats, 0)
 	for rows.Next() {
-		u := &models.User{}
+		s := &models.ReviewerStats{}
+
 		err := rows.Scan(
-			&u.UserId,
-			&u.SystemId,
-			&u.UserName,
+			&s.UserSystemId,
+			&s.UserName,
+			&s.TeamName,
+			&s.Assigned,
+			&s.Open,
+			&s.Merged,
 		)
-
 		if err != nil {
-			logs.PrintLog(ctx, "[repository] GetPullRequestById", err.Error())
+			logs.PrintLog(ctx, "[repository] GetReviewerStats", err.Error())
 			return nil, err
 		}
 
-		pr.AssigneeReviewers = append(pr.AssigneeReviewers, u)
+		stats = append(stats, s)
 	}
 
-	return pr, nil
+	return stats, nil
 }
 
-func (db *Database) SetMergedStatusPullRequest(ctx context.Context, prId int) (sql.NullTime, error) {
+func (db *Database) CreateWebhookSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
 	const query = `
-        UPDATE pull_requests
-        SET 
-            status = 'MERGED',
-            merged_at = NOW()
-        WHERE pull_request_id = $1
-        RETURNING merged_at;
+        INSERT INTO webhook_subscriptions (url, secret, event_types)
+        VALUES ($1, $2, $3)
+        RETURNING subscription_id, created_at;
     `
 
-	var mergedAt sql.NullTime
-
-	err := db.conn.QueryRowContext(ctx, query, prId).Scan(&mergedAt)
+	err := db.conn.QueryRowContext(ctx, query, subscription.Url, subscription.Secret, pq.Array(subscription.EventTypes)).
+		Scan(&subscription.SubscriptionId, &subscription.CreatedAt)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] SetMergedStatusPullRequest", err.Error())
-		return sql.NullTime{}, err
+		logs.PrintLog(ctx, "[repository] CreateWebhookSubscription", err.Error())
+		return err
 	}
 
-	return mergedAt, nil
+	return nil
 }
 
-func (db *Database) ReplaceReviewers(ctx context.Context, prId int, oldReviewerId int, newReviewerId int) error {
-	tx, err := db.conn.BeginTx(ctx, nil)
+func (db *Database) GetWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	const query = `
+        SELECT subscription_id, url, secret, event_types, created_at
+        FROM webhook_subscriptions
+        ORDER BY subscription_id;
+    `
+
+	rows, err := db.conn.QueryContext(ctx, query)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
-		return err
+		logs.PrintLog(ctx, "[repository] GetWebhookSubscriptions", err.Error())
+		return nil, err
 	}
+	defer func() {
+		_ = rows.Close()
+	}()
 
-	const deleteQuery = `
-        DELETE FROM pull_request_reviewers
-        WHERE pull_request_id = $1 AND user_id = $2;
+	subscriptions := make([]*models.WebhookSubscription, 0)
+	for rows.Next() {
+		s := &models.WebhookSubscription{}
+		if err := rows.Scan(&s.SubscriptionId, &s.Url, &s.Secret, pq.Array(&s.EventTypes), &s.CreatedAt); err != nil {
+			logs.PrintLog(ctx, "[repository] GetWebhookSubscriptions", err.Error())
+			return nil, err
+		}
+		subscriptions = append(subscriptions, s)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription removes a subscription with its pending and dead
+// deliveries; false means there was no such subscription.
+func (db *Database) DeleteWebhookSubscription(ctx context.Context, subscriptionId int) (bool, error) {
+	const query = `
+        DELETE FROM webhook_subscriptions
+        WHERE subscription_id = $1;
     `
 
-	result, err := tx.ExecContext(ctx, deleteQuery, prId, oldReviewerId)
+	res, err := db.conn.ExecContext(ctx, query, subscriptionId)
 	if err != nil {
-		_ = tx.Rollback()
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
-		return err
+		logs.PrintLog(ctx, "[repository] DeleteWebhookSubscription", err.Error())
+		return false, err
 	}
 
-	_, err = result.RowsAffected()
+	n, err := res.RowsAffected()
 	if err != nil {
-		_ = tx.Rollback()
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
-		return err
+		logs.PrintLog(ctx, "[repository] DeleteWebhookSubscription", err.Error())
+		return false, err
 	}
 
-	const insertQuery = `
-        INSERT INTO pull_request_reviewers (pull_request_id, user_id)
-        VALUES ($1, $2)
-        ON CONFLICT DO NOTHING;
+	return n > 0, nil
+}
+
+// ClaimWebhookDeliveries takes up to limit due deliveries, counts the attempt
+// and hides them from other dispatchers for the lease.
+func (db *Database) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	const query = `
+        WITH due AS (
+            SELECT delivery_id
+            FROM webhook_deliveries
+            WHERE delivered_at IS NULL AND dead_at IS NULL AND next_attempt_at <= NOW()
+            ORDER BY delivery_id
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        ), claimed AS (
+            UPDATE webhook_deliveries AS d
+            SET attempts = d.attempts + 1,
+                next_attempt_at = NOW() + make_interval(secs => $2)
+            FROM due
+            WHERE d.delivery_id = due.delivery_id
+            RETURNING d.delivery_id, d.subscription_id, d.event_type, d.payload, d.attempts, d.last_error, d.created_at
+        )
+        SELECT c.delivery_id, c.subscription_id, s.url, s.secret, c.event_type, c.payload, c.attempts, c.last_error, c.created_at
+        FROM claimed AS c
+        JOIN webhook_subscriptions AS s ON s.subscription_id = c.subscription_id
+        ORDER BY c.delivery_id;
     `
 
-	result, err = tx.ExecContext(ctx, insertQuery, prId, newReviewerId)
+	rows, err := db.conn.QueryContext(ctx, query, limit, lease.Seconds())
 	if err != nil {
-		_ = tx.Rollback()
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+		logs.PrintLog(ctx, "[repository] ClaimWebhookDeliveries", err.Error())
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	deliveries := make([]*models.WebhookDelivery, 0)
+	for rows.Next() {
+		d := &models.WebhookDelivery{}
+		err := rows.Scan(&d.DeliveryId, &d.SubscriptionId, &d.Url, &d.Secret, &d.EventType, &d.Payload, &d.Attempts, &d.LastError, &d.CreatedAt)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] ClaimWebhookDeliveries", err.Error())
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+func (db *Database) MarkWebhookDelivered(ctx context.Context, deliveryId int) error {
+	const query = `
+        UPDATE webhook_deliveries
+        SET delivered_at = NOW(), last_error = ''
+        WHERE delivery_id = $1;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, deliveryId); err != nil {
+		logs.PrintLog(ctx, "[repository] MarkWebhookDelivered", err.Error())
 		return err
 	}
 
-	_, err = result.RowsAffected()
-	if err != nil {
-		_ = tx.Rollback()
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+	return nil
+}
+
+// RetryWebhookDelivery puts a failed delivery back to the outbox to be tried
+// again after delay.
+func (db *Database) RetryWebhookDelivery(ctx context.Context, deliveryId int, lastError string, delay time.Duration) error {
+	const query = `
+        UPDATE webhook_deliveries
+        SET last_error = $2, next_attempt_at = NOW() + make_interval(secs => $3)
+        WHERE delivery_id = $1;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, deliveryId, lastError, delay.Seconds()); err != nil {
+		logs.PrintLog(ctx, "[repository] RetryWebhookDelivery", err.Error())
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
-		logs.PrintLog(ctx, "[repository] ReplaceReviewers", err.Error())
+	return nil
+}
+
+func (db *Database) MarkWebhookDead(ctx context.Context, deliveryId int, lastError string) error {
+	const query = `
+        UPDATE webhook_deliveries
+        SET last_error = $2, dead_at = NOW()
+        WHERE delivery_id = $1;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, deliveryId, lastError); err != nil {
+		logs.PrintLog(ctx, "[repository] MarkWebhookDead", err.Error())
 		return err
 	}
 
-	logs.PrintLog(ctx, "[repository] ReplaceReviewers", fmt.Sprintf("success replace: %+v -> %+v", oldReviewerId, newReviewerId))
 	return nil
 }
 
-func (db *Database) DeleteReview(ctx context.Context, prId int, userId int) error {
+// GetDeadWebhookDeliveries returns deliveries given up on, latest first.
+func (db *Database) GetDeadWebhookDeliveries(ctx context.Context) ([]*models.WebhookDelivery, error) {
 	const query = `
-        DELETE FROM pull_request_reviewers
-        WHERE pull_request_id = $1 AND user_id = $2;
+        SELECT d.delivery_id, d.subscription_id, s.url, d.event_type, d.payload, d.attempts, d.last_error, d.created_at, d.dead_at
+        FROM webhook_deliveries AS d
+        JOIN webhook_subscriptions AS s ON s.subscription_id = d.subscription_id
+        WHERE d.dead_at IS NOT NULL
+        ORDER BY d.dead_at DESC, d.delivery_id DESC;
     `
 
-	result, err := db.conn.ExecContext(ctx, query, prId, userId)
+	rows, err := db.conn.QueryContext(ctx, query)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
-		return fmt.Errorf("delete reviewer: %w", err)
+		logs.PrintLog(ctx, "[repository] GetDeadWebhookDeliveries", err.Error())
+		return nil, err
 	}
+	defer func() {
+		_ = rows.Close()
+	}()
 
-	_, err = result.RowsAffected()
+	deliveries := make([]*models.WebhookDelivery, 0)
+	for rows.Next() {
+		d := &models.WebhookDelivery{}
+		err := rows.Scan(&d.DeliveryId, &d.SubscriptionId, &d.Url, &d.EventType, &d.Payload, &d.Attempts, &d.LastError, &d.CreatedAt, &d.DeadAt)
+		if err != nil {
+			logs.PrintLog(ctx, "[repository] GetDeadWebhookDeliveries", err.Error())
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+// RecordInboundDelivery notes a code host delivery; false means it was noted
+// before and must not be applied again.
+func (db *Database) RecordInboundDelivery(ctx context.Context, provider string, deliveryId string) (bool, error) {
+	const query = `
+        INSERT INTO inbound_deliveries (provider, delivery_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `
+
+	res, err := db.conn.ExecContext(ctx, query, provider, deliveryId)
 	if err != nil {
-		logs.PrintLog(ctx, "[repository] DeleteReview", err.Error())
+		logs.PrintLog(ctx, "[repository] RecordInboundDelivery", err.Error())
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		logs.PrintLog(ctx, "[repository] RecordInboundDelivery", err.Error())
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// ForgetInboundDelivery drops a delivery that failed to apply, so that its
+// redelivery is tried again.
+func (db *Database) ForgetInboundDelivery(ctx context.Context, provider string, deliveryId string) error {
+	const query = `
+        DELETE FROM inbound_deliveries
+        WHERE provider = $1 AND delivery_id = $2;
+    `
+
+	if _, err := db.conn.ExecContext(ctx, query, provider, deliveryId); err != nil {
+		logs.PrintLog(ctx, "[repository] ForgetInboundDelivery", err.Error())
 		return err
 	}
 
@@ -0,0 +1,147 @@
+package bus
+
+import (
+	"PRmanager/internal/models"
+	"slices"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped.
+const subscriberBuffer = 64
+
+// Filter selects events of a team or of a user; an empty field matches all.
+type Filter struct {
+	TeamName string
+	UserId   string
+}
+
+// Match tells if the event is about the filter team and involves the filter
+// user as the author, a reviewer or the changed user.
+func (f Filter) Match(e *models.LiveEventDTO) bool {
+	if f.TeamName != "" && e.TeamName != f.TeamName {
+		return false
+	}
+
+	if f.UserId == "" {
+		return true
+	}
+
+	return e.AuthorId == f.UserId ||
+		e.OldReviewerId == f.UserId ||
+		e.NewReviewerId == f.UserId ||
+		e.UserId == f.UserId ||
+		slices.Contains(e.Reviewers, f.UserId)
+}
+
+// Bus hands published events to every matching subscriber and keeps the
+// last ones for subscribers that reconnect.
+type Bus struct {
+	mu      sync.Mutex
+	lastId  int64
+	size    int
+	history []models.LiveEventDTO
+	subs    map[*Subscription]struct{}
+}
+
+// New keeps the last historySize events. Event ids start from the current
+// time in microseconds, so they keep growing across restarts and an id from
+// an earlier process replays the whole history.
+func New(historySize int) *Bus {
+	return &Bus{
+		lastId:  time.Now().UnixMicro(),
+		size:    historySize,
+		history: make([]models.LiveEventDTO, 0, historySize),
+		subs:    make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish numbers the event and sends it to the subscribers. A subscriber
+// that fell too far behind is dropped: its channel is closed and it catches
+// up from the history when it subscribes again.
+func (b *Bus) Publish(e models.LiveEventDTO) models.LiveEventDTO {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastId++
+	e.EventId = b.lastId
+	if e.OccurredAt == "" {
+		e.OccurredAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	if b.size > 0 {
+		if len(b.history) == b.size {
+			copy(b.history, b.history[1:])
+			b.history = b.history[:b.size-1]
+		}
+		b.history = append(b.history, e)
+	}
+
+	for sub := range b.subs {
+		if !sub.filter.Match(&e) {
+			continue
+		}
+
+		select {
+		case sub.events <- e:
+		default:
+			b.drop(sub)
+		}
+	}
+
+	return e
+}
+
+// Subscribe starts receiving events matching the filter. Kept events after
+// lastEventId are returned in Missed; 0 skips the replay.
+func (b *Bus) Subscribe(filter Filter, lastEventId int64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make(chan models.LiveEventDTO, subscriberBuffer)
+	sub := &Subscription{
+		Missed: make([]models.LiveEventDTO, 0),
+		Events: events,
+		bus:    b,
+		filter: filter,
+		events: events,
+	}
+
+	if lastEventId > 0 {
+		for i := range b.history {
+			if b.history[i].EventId > lastEventId && filter.Match(&b.history[i]) {
+				sub.Missed = append(sub.Missed, b.history[i])
+			}
+		}
+	}
+
+	b.subs[sub] = struct{}{}
+	return sub
+}
+
+func (b *Bus) drop(sub *Subscription) {
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.events)
+	}
+}
+
+type Subscription struct {
+	// Missed are the kept events published after the last seen one.
+	Missed []models.LiveEventDTO
+	// Events is closed when the subscriber is dropped or closed.
+	Events <-chan models.LiveEventDTO
+
+	bus    *Bus
+	filter Filter
+	events chan models.LiveEventDTO
+}
+
+// Close stops the subscription, it is safe to call more than once.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.drop(s)
+}
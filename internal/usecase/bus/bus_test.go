@@ -0,0 +1,109 @@
+package bus_test
+
+import (
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/bus"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Match(t *testing.T) {
+	e := &models.LiveEventDTO{
+		Type:          models.LiveReviewerReplaced,
+		TeamName:      "backend",
+		AuthorId:      "u1",
+		OldReviewerId: "u2",
+		NewReviewerId: "u3",
+	}
+
+	tests := []struct {
+		name   string
+		filter bus.Filter
+		match  bool
+	}{
+		{name: "no filter", filter: bus.Filter{}, match: true},
+		{name: "team", filter: bus.Filter{TeamName: "backend"}, match: true},
+		{name: "other team", filter: bus.Filter{TeamName: "frontend"}, match: false},
+		{name: "author", filter: bus.Filter{UserId: "u1"}, match: true},
+		{name: "old reviewer", filter: bus.Filter{UserId: "u2"}, match: true},
+		{name: "new reviewer", filter: bus.Filter{UserId: "u3"}, match: true},
+		{name: "other user", filter: bus.Filter{UserId: "u4"}, match: false},
+		{name: "team and user", filter: bus.Filter{TeamName: "backend", UserId: "u3"}, match: true},
+		{name: "user of other team", filter: bus.Filter{TeamName: "frontend", UserId: "u3"}, match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, tt.filter.Match(e))
+		})
+	}
+
+	assert.True(t, bus.Filter{UserId: "u5"}.Match(&models.LiveEventDTO{Reviewers: []string{"u4", "u5"}}))
+	assert.True(t, bus.Filter{UserId: "u5"}.Match(&models.LiveEventDTO{UserId: "u5"}))
+}
+
+func TestBus(t *testing.T) {
+	b := bus.New(2)
+
+	backend := b.Subscribe(bus.Filter{TeamName: "backend"}, 0)
+	defer backend.Close()
+	assert.Empty(t, backend.Missed)
+
+	first := b.Publish(models.LiveEventDTO{Type: models.LiveReviewerAssigned, TeamName: "backend"})
+	second := b.Publish(models.LiveEventDTO{Type: models.LiveReviewerAssigned, TeamName: "frontend"})
+	third := b.Publish(models.LiveEventDTO{Type: models.LivePullRequestMerged, TeamName: "backend"})
+
+	assert.Greater(t, second.EventId, first.EventId)
+	assert.Greater(t, third.EventId, second.EventId)
+	assert.NotEmpty(t, first.OccurredAt)
+
+	assert.Equal(t, first, <-backend.Events)
+	assert.Equal(t, third, <-backend.Events)
+
+	t.Run("reconnect replays missed events", func(t *testing.T) {
+		sub := b.Subscribe(bus.Filter{}, first.EventId)
+		defer sub.Close()
+		assert.Equal(t, []models.LiveEventDTO{second, third}, sub.Missed)
+
+		sub = b.Subscribe(bus.Filter{TeamName: "backend"}, first.EventId)
+		defer sub.Close()
+		assert.Equal(t, []models.LiveEventDTO{third}, sub.Missed)
+	})
+
+	t.Run("only the last events are kept", func(t *testing.T) {
+		sub := b.Subscribe(bus.Filter{}, 1)
+		defer sub.Close()
+		assert.Equal(t, []models.LiveEventDTO{second, third}, sub.Missed)
+	})
+
+	t.Run("closed subscription gets nothing", func(t *testing.T) {
+		sub := b.Subscribe(bus.Filter{}, 0)
+		sub.Close()
+		sub.Close()
+
+		b.Publish(models.LiveEventDTO{Type: models.LiveUserActivity})
+		_, ok := <-sub.Events
+		assert.False(t, ok)
+	})
+}
+
+func TestBus_SlowSubscriberIsDropped(t *testing.T) {
+	b := bus.New(0)
+
+	sub := b.Subscribe(bus.Filter{}, 0)
+	defer sub.Close()
+
+	var last models.LiveEventDTO
+	for range 100 {
+		last = b.Publish(models.LiveEventDTO{Type: models.LiveUserActivity})
+	}
+
+	received := 0
+	for e := range sub.Events {
+		require.Less(t, e.EventId, last.EventId)
+		received++
+	}
+	assert.Equal(t, 64, received)
+}
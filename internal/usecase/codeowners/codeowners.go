@@ -0,0 +1,116 @@
+package codeowners
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid ownership pattern")
+
+// Pattern is a compiled CODEOWNERS-style glob:
+//   - "*" matches anything but "/", "?" a single character but "/";
+//   - "**" matches across directories;
+//   - a leading "/" or a "/" inside the pattern anchors it to the repository
+//     root, otherwise it matches at any depth;
+//   - a pattern that matches a directory matches every file under it, a
+//     trailing "/" matches directories only.
+type Pattern struct {
+	raw string
+	re  *regexp.Regexp
+}
+
+func Compile(pattern string) (*Pattern, error) {
+	p := strings.TrimSpace(pattern)
+	if p == "" || p != pattern || strings.HasPrefix(p, "!") || strings.ContainsAny(p, " \t") {
+		return nil, ErrInvalidPattern
+	}
+
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, ErrInvalidPattern
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, ErrInvalidPattern
+	}
+
+	return &Pattern{raw: pattern, re: re}, nil
+}
+
+func (p *Pattern) Match(path string) bool {
+	return p.re.MatchString(normalize(path))
+}
+
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+// Matcher holds patterns in file order. As in CODEOWNERS, the last matching
+// pattern wins.
+type Matcher struct {
+	patterns []*Pattern
+}
+
+func NewMatcher(patterns []string) (*Matcher, error) {
+	m := &Matcher{patterns: make([]*Pattern, 0, len(patterns))}
+	for _, raw := range patterns {
+		p, err := Compile(raw)
+		if err != nil {
+			return nil, err
+		}
+		m.patterns = append(m.patterns, p)
+	}
+
+	return m, nil
+}
+
+// Match returns the index of the pattern owning path, or -1 when none matches.
+func (m *Matcher) Match(path string) int {
+	for i := len(m.patterns) - 1; i >= 0; i-- {
+		if m.patterns[i].Match(path) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalize(path string) string {
+	path = strings.TrimPrefix(path, "./")
+	return strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,68 @@
+package codeowners_test
+
+import (
+	"PRmanager/internal/usecase/codeowners"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/usecase/usecase.go", true},
+		{"*.go", "README.md", false},
+		{"/*.go", "main.go", true},
+		{"/*.go", "app/main.go", false},
+		{"docs/", "docs/api/index.md", true},
+		{"docs/", "docs", false},
+		{"docs", "docs/index.md", true},
+		{"docs", "site/docs/index.md", true},
+		{"internal/usecase", "internal/usecase/selector/selector.go", true},
+		{"internal/usecase", "pkg/internal/usecase/x.go", false},
+		{"/internal/*.go", "internal/a.go", true},
+		{"/internal/*.go", "internal/usecase/a.go", false},
+		{"**/migrations", "db/migrations/001.sql", true},
+		{"**/migrations", "migrations/001.sql", true},
+		{"app/**/handler.go", "app/handler.go", true},
+		{"app/**/handler.go", "app/v1/http/handler.go", true},
+		{"pkg/**", "pkg/logs/logs.go", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{"a.b", "axb", false},
+		{"*.go", "./main.go", true},
+	}
+
+	for _, tt := range tests {
+		p, err := codeowners.Compile(tt.pattern)
+		assert.NoError(t, err, tt.pattern)
+		assert.Equal(t, tt.match, p.Match(tt.path), "%s ~ %s", tt.pattern, tt.path)
+	}
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for _, pattern := range []string{"", "/", " *.go", "!*.go", "a b"} {
+		_, err := codeowners.Compile(pattern)
+		assert.ErrorIs(t, err, codeowners.ErrInvalidPattern, pattern)
+	}
+}
+
+func TestMatcher_LastMatchWins(t *testing.T) {
+	m, err := codeowners.NewMatcher([]string{"*", "*.go", "/docs/"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, m.Match("internal/models/models.go"))
+	assert.Equal(t, 2, m.Match("docs/intro.md"))
+	assert.Equal(t, 0, m.Match("Dockerfile"))
+
+	m, err = codeowners.NewMatcher([]string{"/app/"})
+	assert.NoError(t, err)
+	assert.Equal(t, -1, m.Match("pkg/app/x.go"))
+
+	_, err = codeowners.NewMatcher([]string{"*.go", ""})
+	assert.ErrorIs(t, err, codeowners.ErrInvalidPattern)
+}
@@ -0,0 +1,260 @@
+package notify
+
+import (
+	"PRmanager/internal/models"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Kinds of review notifications.
+const (
+	KindAssigned   = "assigned"
+	KindUnassigned = "unassigned"
+	KindMerged     = "merged"
+)
+
+// Message is a rendered notification.
+type Message struct {
+	Subject string
+	Text    string
+}
+
+// Notifier delivers a message to a user through one channel. A contact
+// without that channel is skipped without an error.
+type Notifier interface {
+	Notify(ctx context.Context, to *models.Contact, msg Message) error
+}
+
+// Event is what happened to a pull request; it is rendered into a message
+// for each notified user.
+type Event struct {
+	Kind            string
+	PullRequestId   string
+	PullRequestName string
+	AuthorId        string
+	// ReplacedBy is the reviewer who took over an unassigned review.
+	ReplacedBy string
+}
+
+type templates struct {
+	subject *template.Template
+	text    *template.Template
+}
+
+func newTemplates(subject, text string) templates {
+	return templates{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		text:    template.Must(template.New("text").Parse(text)),
+	}
+}
+
+var messages = map[string]templates{
+	KindAssigned: newTemplates(
+		`Review requested: {{.PullRequestName}}`,
+		`Hi {{.Recipient}}, you were assigned to review {{.PullRequestName}} ({{.PullRequestId}}) by {{.AuthorId}}.`,
+	),
+	KindUnassigned: newTemplates(
+		`Review unassigned: {{.PullRequestName}}`,
+		`Hi {{.Recipient}}, you no longer review {{.PullRequestName}} ({{.PullRequestId}}){{if .ReplacedBy}}, {{.ReplacedBy}} took it over{{end}}.`,
+	),
+	KindMerged: newTemplates(
+		`Merged: {{.PullRequestName}}`,
+		`Hi {{.Recipient}}, {{.PullRequestName}} ({{.PullRequestId}}) by {{.AuthorId}} was merged.`,
+	),
+}
+
+// Render fills the template of the event kind for the given user.
+func Render(e Event, to *models.Contact) (Message, error) {
+	t, ok := messages[e.Kind]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown notification kind %q", e.Kind)
+	}
+
+	recipient := to.UserName
+	if recipient == "" {
+		recipient = to.SystemId
+	}
+
+	data := struct {
+		Event
+		Recipient string
+	}{e, recipient}
+
+	var subject, text strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{Subject: subject.String(), Text: text.String()}, nil
+}
+
+// Multi sends a message through every notifier, one failing channel does not
+// stop the others.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, to *models.Contact, msg Message) error {
+	errs := make([]error, 0)
+	for _, n := range m {
+		if err := n.Notify(ctx, to, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Slack posts messages to Slack-compatible incoming webhooks.
+type Slack struct {
+	http *http.Client
+}
+
+func NewSlack(timeout time.Duration) *Slack {
+	return &Slack{http: &http.Client{Timeout: timeout}}
+}
+
+func (s *Slack) Notify(ctx context.Context, to *models.Contact, msg Message) error {
+	if to.SlackWebhookUrl == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", msg.Subject, msg.Text),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.SlackWebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("slack answered %s", resp.Status)
+	}
+
+	return nil
+}
+
+// SMTP sends messages as plain text emails. STARTTLS is used when the server
+// offers it; the credentials are only sent when a username is set.
+type SMTP struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+func NewSMTP(host, port, username, password, from string, timeout time.Duration) *SMTP {
+	return &SMTP{
+		addr:     net.JoinHostPort(host, port),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+		timeout:  timeout,
+	}
+}
+
+func (s *SMTP) Notify(ctx context.Context, to *models.Contact, msg Message) error {
+	if to.Email == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	if err := s.send(c, to.Email, msg); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+func (s *SMTP) send(c *smtp.Client, to string, msg Message) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(nil); err != nil {
+			return err
+		}
+	}
+
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(email(s.from, to, msg)); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+func email(from, to string, msg Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Text, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
@@ -0,0 +1,216 @@
+package notify_test
+
+import (
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/notify"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var reviewer = &models.Contact{
+	UserId:   2,
+	SystemId: "u2",
+	UserName: "Bob",
+	Email:    "bob@example.com",
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   notify.Event
+		subject string
+		text    string
+	}{
+		{
+			name:    "assigned",
+			event:   notify.Event{Kind: notify.KindAssigned, PullRequestId: "PR1", PullRequestName: "Add search", AuthorId: "u1"},
+			subject: "Review requested: Add search",
+			text:    "Hi Bob, you were assigned to review Add search (PR1) by u1.",
+		},
+		{
+			name:    "unassigned and replaced",
+			event:   notify.Event{Kind: notify.KindUnassigned, PullRequestId: "PR1", PullRequestName: "Add search", ReplacedBy: "u3"},
+			subject: "Review unassigned: Add search",
+			text:    "Hi Bob, you no longer review Add search (PR1), u3 took it over.",
+		},
+		{
+			name:    "unassigned without replacement",
+			event:   notify.Event{Kind: notify.KindUnassigned, PullRequestId: "PR1", PullRequestName: "Add search"},
+			subject: "Review unassigned: Add search",
+			text:    "Hi Bob, you no longer review Add search (PR1).",
+		},
+		{
+			name:    "merged",
+			event:   notify.Event{Kind: notify.KindMerged, PullRequestId: "PR1", PullRequestName: "Add search", AuthorId: "u1"},
+			subject: "Merged: Add search",
+			text:    "Hi Bob, Add search (PR1) by u1 was merged.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := notify.Render(tt.event, reviewer)
+			require.NoError(t, err)
+			assert.Equal(t, tt.subject, msg.Subject)
+			assert.Equal(t, tt.text, msg.Text)
+		})
+	}
+
+	_, err := notify.Render(notify.Event{Kind: "unknown"}, reviewer)
+	assert.Error(t, err)
+}
+
+func TestSlack_Notify(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	to := &models.Contact{SystemId: "u2", SlackWebhookUrl: srv.URL}
+	err := notify.NewSlack(time.Second).Notify(context.Background(), to, notify.Message{Subject: "Merged: PR", Text: "done"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"text": "*Merged: PR*\ndone"}, got)
+
+	t.Run("no webhook url is skipped", func(t *testing.T) {
+		err := notify.NewSlack(time.Second).Notify(context.Background(), reviewer, notify.Message{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("slack error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		to := &models.Contact{SlackWebhookUrl: srv.URL}
+		err := notify.NewSlack(time.Second).Notify(context.Background(), to, notify.Message{})
+		assert.EqualError(t, err, "slack answered 404 Not Found")
+	})
+}
+
+// mail is what the stub SMTP server received.
+type mail struct {
+	from, to, data string
+}
+
+// smtpStub accepts a single session on a local port and sends the received
+// mail to the returned channel.
+func smtpStub(t *testing.T) (host, port string, mails <-chan mail) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	ch := make(chan mail, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		tp := textproto.NewConn(conn)
+		var m mail
+		_ = tp.PrintfLine("220 stub ready")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			switch cmd {
+			case "EHLO", "HELO":
+				_ = tp.PrintfLine("250 stub")
+			case "MAIL":
+				m.from = strings.TrimPrefix(line, "MAIL FROM:")
+				_ = tp.PrintfLine("250 ok")
+			case "RCPT":
+				m.to = strings.TrimPrefix(line, "RCPT TO:")
+				_ = tp.PrintfLine("250 ok")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				m.data = string(data)
+				_ = tp.PrintfLine("250 queued")
+				ch <- m
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	host, port, err = net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+	return host, port, ch
+}
+
+func TestSMTP_Notify(t *testing.T) {
+	host, port, mails := smtpStub(t)
+
+	n := notify.NewSMTP(host, port, "", "", "pr@example.com", time.Second)
+	err := n.Notify(context.Background(), reviewer, notify.Message{Subject: "Merged: PR", Text: "done"})
+	require.NoError(t, err)
+
+	m := <-mails
+	assert.Equal(t, "<pr@example.com>", m.from)
+	assert.Equal(t, "<bob@example.com>", m.to)
+
+	r := textproto.NewReader(bufio.NewReader(strings.NewReader(m.data)))
+	header, err := r.ReadMIMEHeader()
+	require.NoError(t, err)
+	assert.Equal(t, "Merged: PR", header.Get("Subject"))
+	assert.Equal(t, "bob@example.com", header.Get("To"))
+	assert.Equal(t, "text/plain; charset=utf-8", header.Get("Content-Type"))
+
+	body, err := r.ReadLine()
+	require.NoError(t, err)
+	assert.Equal(t, "done", body)
+
+	t.Run("no email is skipped", func(t *testing.T) {
+		to := &models.Contact{SystemId: "u2"}
+		err := notify.NewSMTP("127.0.0.1", "1", "", "", "pr@example.com", time.Second).Notify(context.Background(), to, notify.Message{})
+		assert.NoError(t, err)
+	})
+}
+
+type failingNotifier struct {
+	calls int
+}
+
+func (f *failingNotifier) Notify(ctx context.Context, to *models.Contact, msg notify.Message) error {
+	f.calls++
+	return errors.New("channel is down")
+}
+
+func TestMulti_Notify(t *testing.T) {
+	first, second := &failingNotifier{}, &failingNotifier{}
+
+	err := notify.Multi{first, second}.Notify(context.Background(), reviewer, notify.Message{})
+	assert.EqualError(t, err, "channel is down\nchannel is down")
+	assert.Equal(t, 1, first.calls)
+	assert.Equal(t, 1, second.calls)
+
+	assert.NoError(t, notify.Multi{}.Notify(context.Background(), reviewer, notify.Message{}))
+}
@@ -0,0 +1,291 @@
+package selector
+
+import (
+	"PRmanager/internal/models"
+	"context"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyRoundRobin  = "round_robin"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyWeighted    = "weighted"
+	StrategyLeastPaired = "least_paired"
+
+	DefaultStrategy = StrategyRandom
+
+	// DefaultPairingLookback is used when a request carries no lookback.
+	DefaultPairingLookback = 30 * 24 * time.Hour
+)
+
+type Request struct {
+	TeamId     int
+	Candidates []*models.User
+	Count      int
+	// AuthorId and Lookback are used by strategies looking at the review
+	// history between the author and the candidates.
+	AuthorId int
+	Lookback time.Duration
+	// Rand is the random source of the selection, see NewRand. A source with
+	// an unknown seed is used when it is nil.
+	Rand *rand.Rand
+}
+
+// NewRand returns the random source for the given seed. Selections made with
+// sources of the same seed over the same candidates pick the same reviewers.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), 0))
+}
+
+func source(req Request) *rand.Rand {
+	if req.Rand != nil {
+		return req.Rand
+	}
+	return NewRand(rand.Int64())
+}
+
+// byUserId returns a copy of the candidates ordered by user id, so random
+// picks do not depend on the order the candidates were loaded in.
+func byUserId(candidates []*models.User) []*models.User {
+	out := copyCandidates(candidates)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].UserId < out[j].UserId
+	})
+	return out
+}
+
+func shuffled(req Request) []*models.User {
+	candidates := byUserId(req.Candidates)
+	source(req).Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	return candidates
+}
+
+type ReviewerSelector interface {
+	Select(ctx context.Context, req Request) ([]*models.User, error)
+}
+
+// LoadCounter returns the number of open reviews for each of the given users.
+type LoadCounter interface {
+	OpenReviewCounts(ctx context.Context, userIds []int) (map[int]int, error)
+}
+
+// PairingHistory returns how often and how recently each of the given users
+// reviewed pull requests of the author since the given time. Users without
+// such reviews may be missing from the result.
+type PairingHistory interface {
+	Pairings(ctx context.Context, authorId int, userIds []int, since time.Time) (map[int]models.Pairing, error)
+}
+
+func NewSelectors(loads LoadCounter, history PairingHistory) map[string]ReviewerSelector {
+	return map[string]ReviewerSelector{
+		StrategyRandom:      NewRandom(),
+		StrategyRoundRobin:  NewRoundRobin(),
+		StrategyLeastLoaded: NewLeastLoaded(loads),
+		StrategyWeighted:    NewWeighted(),
+		StrategyLeastPaired: NewLeastPaired(history),
+	}
+}
+
+func limit(req Request) int {
+	if req.Count > len(req.Candidates) {
+		return len(req.Candidates)
+	}
+	if req.Count < 0 {
+		return 0
+	}
+	return req.Count
+}
+
+func copyCandidates(candidates []*models.User) []*models.User {
+	out := make([]*models.User, len(candidates))
+	copy(out, candidates)
+	return out
+}
+
+type Random struct{}
+
+func NewRandom() *Random {
+	return &Random{}
+}
+
+func (s *Random) Select(_ context.Context, req Request) ([]*models.User, error) {
+	return shuffled(req)[:limit(req)], nil
+}
+
+// RoundRobin walks team members ordered by user id and remembers, per team,
+// the last user it picked, so the next request continues from that point.
+type RoundRobin struct {
+	mu   sync.Mutex
+	last map[int]int
+}
+
+func NewRoundRobin() *RoundRobin {
+	return &RoundRobin{last: make(map[int]int)}
+}
+
+func (s *RoundRobin) Select(_ context.Context, req Request) ([]*models.User, error) {
+	n := limit(req)
+	if n == 0 {
+		return []*models.User{}, nil
+	}
+
+	candidates := copyCandidates(req.Candidates)
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].UserId < candidates[j].UserId
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := 0
+	if last, ok := s.last[req.TeamId]; ok {
+		start = sort.Search(len(candidates), func(i int) bool {
+			return candidates[i].UserId > last
+		}) % len(candidates)
+	}
+
+	reviewers := make([]*models.User, 0, n)
+	for i := 0; i < n; i++ {
+		reviewers = append(reviewers, candidates[(start+i)%len(candidates)])
+	}
+
+	s.last[req.TeamId] = reviewers[len(reviewers)-1].UserId
+	return reviewers, nil
+}
+
+// LeastLoaded picks the candidates with the fewest open reviews.
+type LeastLoaded struct {
+	loads LoadCounter
+}
+
+func NewLeastLoaded(loads LoadCounter) *LeastLoaded {
+	return &LeastLoaded{loads: loads}
+}
+
+func (s *LeastLoaded) Select(ctx context.Context, req Request) ([]*models.User, error) {
+	n := limit(req)
+	if n == 0 {
+		return []*models.User{}, nil
+	}
+
+	userIds := make([]int, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		userIds = append(userIds, c.UserId)
+	}
+
+	counts, err := s.loads.OpenReviewCounts(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// ties are broken by user id, so equal load always gives the same pick
+	candidates := copyCandidates(req.Candidates)
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := counts[candidates[i].UserId], counts[candidates[j].UserId]
+		if ci != cj {
+			return ci < cj
+		}
+		return candidates[i].UserId < candidates[j].UserId
+	})
+
+	return candidates[:n], nil
+}
+
+// Weighted draws reviewers without replacement, each candidate being picked
+// with a probability proportional to its review weight.
+type Weighted struct{}
+
+func NewWeighted() *Weighted {
+	return &Weighted{}
+}
+
+func (s *Weighted) Select(_ context.Context, req Request) ([]*models.User, error) {
+	n := limit(req)
+	r := source(req)
+
+	type keyed struct {
+		user *models.User
+		key  float64
+	}
+
+	items := make([]keyed, 0, len(req.Candidates))
+	for _, c := range byUserId(req.Candidates) {
+		weight := c.ReviewWeight
+		if weight <= 0 {
+			weight = 1
+		}
+		items = append(items, keyed{user: c, key: math.Pow(r.Float64(), 1/float64(weight))})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].key > items[j].key
+	})
+
+	reviewers := make([]*models.User, 0, n)
+	for _, it := range items[:n] {
+		reviewers = append(reviewers, it.user)
+	}
+
+	return reviewers, nil
+}
+
+// LeastPaired prefers candidates who have not reviewed the author lately.
+// Every candidate is scored by the number of the author's pull requests it
+// reviewed inside the lookback window plus up to one point for how recent the
+// last of them is; the lowest scores win and ties are picked at random.
+type LeastPaired struct {
+	history PairingHistory
+	now     func() time.Time
+}
+
+func NewLeastPaired(history PairingHistory) *LeastPaired {
+	return &LeastPaired{history: history, now: time.Now}
+}
+
+func (s *LeastPaired) Select(ctx context.Context, req Request) ([]*models.User, error) {
+	n := limit(req)
+	if n == 0 {
+		return []*models.User{}, nil
+	}
+
+	lookback := req.Lookback
+	if lookback <= 0 {
+		lookback = DefaultPairingLookback
+	}
+
+	userIds := make([]int, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		userIds = append(userIds, c.UserId)
+	}
+
+	now := s.now()
+	pairings, err := s.history.Pairings(ctx, req.AuthorId, userIds, now.Add(-lookback))
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[int]float64, len(req.Candidates))
+	for _, c := range req.Candidates {
+		p, ok := pairings[c.UserId]
+		if !ok || p.Reviews == 0 {
+			continue
+		}
+
+		recency := 1 - float64(now.Sub(p.LastAt))/float64(lookback)
+		scores[c.UserId] = float64(p.Reviews) + math.Max(0, math.Min(1, recency))
+	}
+
+	candidates := shuffled(req)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].UserId] < scores[candidates[j].UserId]
+	})
+
+	return candidates[:n], nil
+}
@@ -0,0 +1,150 @@
+package selector_test
+
+import (
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/selector"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubLoads struct {
+	counts map[int]int
+	err    error
+}
+
+func (s *stubLoads) OpenReviewCounts(_ context.Context, _ []int) (map[int]int, error) {
+	return s.counts, s.err
+}
+
+type stubHistory struct {
+	pairings map[int]models.Pairing
+	since    time.Time
+	authorId int
+}
+
+func (s *stubHistory) Pairings(_ context.Context, authorId int, _ []int, since time.Time) (map[int]models.Pairing, error) {
+	s.authorId, s.since = authorId, since
+	return s.pairings, nil
+}
+
+func users(ids ...int) []*models.User {
+	out := make([]*models.User, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, &models.User{UserId: id, ReviewWeight: 1})
+	}
+	return out
+}
+
+func ids(list []*models.User) []int {
+	out := make([]int, 0, len(list))
+	for _, u := range list {
+		out = append(out, u.UserId)
+	}
+	return out
+}
+
+func TestRandom_Select(t *testing.T) {
+	candidates := users(1, 2, 3)
+
+	out, err := selector.NewRandom().Select(context.Background(), selector.Request{Candidates: candidates, Count: 2, Rand: selector.NewRand(7)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, ids(out))
+	assert.Equal(t, []int{1, 2, 3}, ids(candidates))
+
+	// the same seed replays the pick whatever the order of candidates
+	out, err = selector.NewRandom().Select(context.Background(), selector.Request{Candidates: users(3, 1, 2), Count: 2, Rand: selector.NewRand(7)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, ids(out))
+
+	out, err = selector.NewRandom().Select(context.Background(), selector.Request{Candidates: users(1), Count: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, ids(out))
+}
+
+func TestRoundRobin_Select(t *testing.T) {
+	s := selector.NewRoundRobin()
+	ctx := context.Background()
+
+	out, _ := s.Select(ctx, selector.Request{TeamId: 1, Candidates: users(3, 1, 2), Count: 2})
+	assert.Equal(t, []int{1, 2}, ids(out))
+
+	out, _ = s.Select(ctx, selector.Request{TeamId: 1, Candidates: users(3, 1, 2), Count: 2})
+	assert.Equal(t, []int{3, 1}, ids(out))
+
+	// other teams keep their own position
+	out, _ = s.Select(ctx, selector.Request{TeamId: 2, Candidates: users(1, 2, 3), Count: 1})
+	assert.Equal(t, []int{1}, ids(out))
+
+	// the last picked user left the candidate list
+	out, _ = s.Select(ctx, selector.Request{TeamId: 1, Candidates: users(2, 3), Count: 1})
+	assert.Equal(t, []int{2}, ids(out))
+
+	out, _ = s.Select(ctx, selector.Request{TeamId: 1, Candidates: nil, Count: 2})
+	assert.Empty(t, out)
+}
+
+func TestLeastLoaded_Select(t *testing.T) {
+	loads := &stubLoads{counts: map[int]int{1: 3, 2: 0, 3: 1, 4: 0}}
+	s := selector.NewLeastLoaded(loads)
+
+	out, err := s.Select(context.Background(), selector.Request{Candidates: users(1, 2, 3, 4), Count: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 3}, ids(out))
+
+	// equal load is resolved by user id whatever the input order
+	out, err = s.Select(context.Background(), selector.Request{Candidates: users(4, 2), Count: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2}, ids(out))
+
+	loads.err = errors.New("db")
+	out, err = s.Select(context.Background(), selector.Request{Candidates: users(1, 2), Count: 1})
+	assert.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestWeighted_Select(t *testing.T) {
+	candidates := []*models.User{
+		{UserId: 1, ReviewWeight: 1000},
+		{UserId: 2, ReviewWeight: 1},
+		{UserId: 3, ReviewWeight: 1},
+	}
+
+	out, err := selector.NewWeighted().Select(context.Background(), selector.Request{Candidates: candidates, Count: 2, Rand: selector.NewRand(7)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids(out))
+
+	out, err = selector.NewWeighted().Select(context.Background(), selector.Request{Candidates: candidates, Count: 5, Rand: selector.NewRand(7)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids(out))
+}
+
+func TestLeastPaired_Select(t *testing.T) {
+	now := time.Now()
+	history := &stubHistory{pairings: map[int]models.Pairing{
+		1: {Reviews: 3, LastAt: now.Add(-20 * 24 * time.Hour)},
+		2: {Reviews: 1, LastAt: now.Add(-time.Hour)},
+		3: {Reviews: 1, LastAt: now.Add(-25 * 24 * time.Hour)},
+	}}
+	s := selector.NewLeastPaired(history)
+
+	out, err := s.Select(context.Background(), selector.Request{
+		Candidates: users(1, 2, 3, 4),
+		Count:      3,
+		AuthorId:   7,
+		Lookback:   30 * 24 * time.Hour,
+	})
+	assert.NoError(t, err)
+	// never paired first, then the older of two single pairings
+	assert.Equal(t, []int{4, 3, 2}, ids(out))
+	assert.Equal(t, 7, history.authorId)
+	assert.WithinDuration(t, now.Add(-30*24*time.Hour), history.since, time.Minute)
+
+	// no lookback falls back to the default window
+	_, err = s.Select(context.Background(), selector.Request{Candidates: users(1), Count: 1})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-selector.DefaultPairingLookback), history.since, time.Minute)
+}
@@ -3,425 +3,3791 @@ package usecase
 import (
 	"PRmanager/internal/models"
 	"PRmanager/internal/repository"
+	"PRmanager/internal/usecase/bus"
+	"PRmanager/internal/usecase/codeowners"
+	"PRmanager/internal/usecase/notify"
+	"PRmanager/internal/usecase/selector"
+	"PRmanager/internal/usecase/webhook"
+	"PRmanager/pkg/actor"
 	appErrors "PRmanager/pkg/app_errors"
 	"PRmanager/pkg/logs"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"math/rand/v2"
+	"net/mail"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 )
 
 type UsecaseInterface interface {
 	AddTeam(ctx context.Context, dto *models.TeamDTO) error
 	GetTeamByName(ctx context.Context, teamName string) (*models.TeamDTO, error)
+	SetReviewerStrategy(ctx context.Context, dto *models.SetReviewerStrategyDTO) (*models.TeamDTO, error)
+	SetReviewersCount(ctx context.Context, dto *models.SetReviewersCountDTO) (*models.TeamDTO, error)
+	SetRequiredApprovals(ctx context.Context, dto *models.SetRequiredApprovalsDTO) (*models.TeamDTO, error)
+	SetMergePolicy(ctx context.Context, dto *models.SetMergePolicyDTO) (*models.TeamDTO, error)
+	SetReassignPool(ctx context.Context, dto *models.SetReassignPoolDTO) (*models.TeamDTO, error)
+	SetPairingLookback(ctx context.Context, dto *models.SetPairingLookbackDTO) (*models.TeamDTO, error)
+	SetPartnerTeams(ctx context.Context, dto *models.SetPartnerTeamsDTO) (*models.TeamDTO, error)
+	AddOwnershipRule(ctx context.Context, dto *models.OwnershipRuleDTO) (*models.OwnershipRuleDTO, error)
+	GetOwnershipRules(ctx context.Context, teamName string) (*models.OwnershipRulesDTO, error)
+	UpdateOwnershipRule(ctx context.Context, dto *models.OwnershipRuleDTO) (*models.OwnershipRuleDTO, error)
+	DeleteOwnershipRule(ctx context.Context, dto *models.DeleteOwnershipRuleDTO) error
+	UpdateTeamMembers(ctx context.Context, dto *models.UpdateTeamMembersDTO) (*models.TeamMembersUpdatedDTO, error)
+	RemoveTeamMembers(ctx context.Context, dto *models.RemoveTeamMembersDTO) (*models.TeamMembersUpdatedDTO, error)
+	MoveUser(ctx context.Context, dto *models.MoveUserDTO) (*models.TeamMembersUpdatedDTO, error)
 	SetIsActive(ctx context.Context, dto *models.SetIsActiveDTO) (*models.UserDTO, error)
+	SetUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error)
+	AddUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error)
+	RemoveUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error)
+	SetMaxOpenReviews(ctx context.Context, dto *models.SetMaxOpenReviewsDTO) (*models.UserDTO, error)
+	SetContacts(ctx context.Context, dto *models.UserContactsDTO) (*models.UserContactsDTO, error)
+	GetContacts(ctx context.Context, userSystemId string) (*models.UserContactsDTO, error)
+	AddUnavailability(ctx context.Context, dto *models.UnavailabilityDTO) (*models.UnavailabilityDTO, error)
+	GetUnavailability(ctx context.Context, userSystemId string) (*models.UserUnavailabilityDTO, error)
+	RemoveUnavailability(ctx context.Context, dto *models.RemoveUnavailabilityDTO) error
+	ReassignUnavailableReviews(ctx context.Context) error
+	RunUnavailabilityJob(ctx context.Context, interval time.Duration)
+	BulkDeactivate(ctx context.Context, dto *models.BulkDeactivateDTO) (*models.BulkDeactivateResultDTO, error)
 	GetReview(ctx context.Context, userSystemId string) (*models.ReviewDTO, error)
 	CreatePullRequest(ctx context.Context, dto *models.InputCreatePullRequestDTO) (*models.OutputCreatePullRequestDTO, error)
 	MergePullRequest(ctx context.Context, dto *models.InputMergePullRequestDTO) (*models.OutputMergePullRequestDTO, error)
+	MarkReadyPullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error)
+	ClosePullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error)
+	ReopenPullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error)
 	Reassign(ctx context.Context, dto *models.InputReassignDTO) (*models.OutputReassignDTO, error)
+	AddReviewers(ctx context.Context, dto *models.InputChangeReviewersDTO) (*models.OutputChangeReviewersDTO, error)
+	RemoveReviewers(ctx context.Context, dto *models.InputChangeReviewersDTO) (*models.OutputChangeReviewersDTO, error)
+	SubmitReview(ctx context.Context, dto *models.InputSubmitReviewDTO) (*models.OutputSubmitReviewDTO, error)
+	GetPullRequestHistory(ctx context.Context, prSystemId string) (*models.PullRequestHistoryDTO, error)
+	GetAssignmentExplanation(ctx context.Context, prSystemId string) (*models.AssignmentExplanationsDTO, error)
+	GetAssignmentStats(ctx context.Context, dto *models.InputAssignmentStatsDTO) (*models.AssignmentStatsDTO, error)
+	AddWebhook(ctx context.Context, dto *models.WebhookDTO) (*models.WebhookDTO, error)
+	GetWebhooks(ctx context.Context) (*models.WebhooksDTO, error)
+	RemoveWebhook(ctx context.Context, dto *models.RemoveWebhookDTO) error
+	GetWebhookDeadLetters(ctx context.Context) (*models.WebhookDeadLettersDTO, error)
+	DispatchWebhooks(ctx context.Context) error
+	RunWebhookDispatcher(ctx context.Context, interval time.Duration)
+	HandlePullRequestEvent(ctx context.Context, event *models.InboundPullRequestEvent) (*models.InboundWebhookResultDTO, error)
+	SubscribeEvents(ctx context.Context, filter *models.LiveEventFilterDTO) *bus.Subscription
 }
 
+const (
+	defaultMinReviewers        = 0
+	defaultMaxReviewers        = 2
+	defaultPairingLookbackDays = 30
+)
+
+const (
+	webhookTimeout   = 10 * time.Second
+	webhookBatchSize = 50
+	// webhookLease hides claimed deliveries from other dispatchers while they
+	// are being sent, it has to outlast a whole batch of timeouts.
+	webhookLease = webhookBatchSize * webhookTimeout
+)
+
+// notifyTimeout bounds sending one batch of notifications, they outlive the
+// request that caused them.
+const notifyTimeout = time.Minute
+
+// liveHistorySize is how many live events are kept for reconnecting clients.
+const liveHistorySize = 1000
+
 type UseCase struct {
+	repo      repository.RepositoryInterface
+	selectors map[string]selector.ReviewerSelector
+	seeds     SeedProvider
+	webhooks  WebhookSender
+	notifier  notify.Notifier
+	events    *bus.Bus
+}
+
+// WebhookSender delivers a queued webhook to its receiver.
+type WebhookSender interface {
+	Send(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// SeedProvider hands out the seed of every reviewer selection. The seed is
+// stored with the assignment events, so the selection can be replayed.
+type SeedProvider interface {
+	Seed() int64
+}
+
+type randomSeeds struct{}
+
+func (randomSeeds) Seed() int64 {
+	return rand.Int64()
+}
+
+// FixedSeed hands out the same seed every time.
+type FixedSeed int64
+
+func (s FixedSeed) Seed() int64 {
+	return int64(s)
+}
+
+type Option func(*UseCase)
+
+// WithSeeds replaces the default random seeds.
+func WithSeeds(seeds SeedProvider) Option {
+	return func(u *UseCase) {
+		u.seeds = seeds
+	}
+}
+
+// WithWebhookSender replaces the default HTTP webhook client.
+func WithWebhookSender(sender WebhookSender) Option {
+	return func(u *UseCase) {
+		u.webhooks = sender
+	}
+}
+
+// WithNotifier turns on review notifications sent through notifier.
+func WithNotifier(notifier notify.Notifier) Option {
+	return func(u *UseCase) {
+		u.notifier = notifier
+	}
+}
+
+// WithEventBus publishes live events to a bus shared with other consumers.
+func WithEventBus(events *bus.Bus) Option {
+	return func(u *UseCase) {
+		u.events = events
+	}
+}
+
+func NewUseCase(repo repository.RepositoryInterface, opts ...Option) *UseCase {
+	u := &UseCase{
+		repo:      repo,
+		selectors: selector.NewSelectors(&reviewLoadCounter{repo: repo}, &pairingHistory{repo: repo}),
+		seeds:     randomSeeds{},
+		webhooks:  webhook.NewClient(webhookTimeout),
+		events:    bus.New(liveHistorySize),
+	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
+}
+
+// newRand starts a reviewer selection and returns its seed with the random
+// source built from it.
+func (u *UseCase) newRand() (int64, *rand.Rand) {
+	seed := u.seeds.Seed()
+	return seed, selector.NewRand(seed)
+}
+
+// available tells whether the user can be picked as a reviewer right now.
+func available(user *models.User) bool {
+	return user.IsActive && !user.Unavailable
+}
+
+// withinCapacity drops users who already hold as many open reviews as they
+// may and tells whether anyone was dropped.
+func withinCapacity(users []*models.User) ([]*models.User, bool) {
+	kept := make([]*models.User, 0, len(users))
+	for _, user := range users {
+		if atCapacity(user) {
+			continue
+		}
+		kept = append(kept, user)
+	}
+	return kept, len(kept) < len(users)
+}
+
+func atCapacity(user *models.User) bool {
+	return user.MaxOpenReviews != nil && user.OpenReviews >= *user.MaxOpenReviews
+}
+
+// assignmentTrace notes every user a reviewer selection for pr looks at, so
+// that the selection can be explained later. The reasons mirror the filters
+// applied to candidates before selection. A nil trace records nothing.
+type assignmentTrace struct {
+	pr         *models.PullRequest
+	strategy   string
+	assigned   map[string]bool
+	seen       map[string]*models.AssignmentCandidate
+	candidates []*models.AssignmentCandidate
+}
+
+func newAssignmentTrace(pr *models.PullRequest, strategy string) *assignmentTrace {
+	assigned := make(map[string]bool, len(pr.AssigneeReviewers))
+	for _, r := range pr.AssigneeReviewers {
+		assigned[r.SystemId] = true
+	}
+
+	return &assignmentTrace{
+		pr:       pr,
+		strategy: strategy,
+		assigned: assigned,
+		seen:     make(map[string]*models.AssignmentCandidate),
+	}
+}
+
+// consider records users not seen yet with the reason each of them can not
+// be picked, if any.
+func (t *assignmentTrace) consider(users []*models.User) {
+	if t == nil {
+		return
+	}
+
+	for _, user := range users {
+		if _, ok := t.seen[user.SystemId]; ok {
+			continue
+		}
+
+		c := &models.AssignmentCandidate{UserId: user.UserId, SystemId: user.SystemId}
+		switch {
+		case user.SystemId == t.pr.AuthorSystemId:
+			c.ExcludedReason = models.ExcludedAuthor
+		case !user.IsActive:
+			c.ExcludedReason = models.ExcludedInactive
+		case user.Unavailable:
+			c.ExcludedReason = models.ExcludedUnavailable
+		case t.assigned[user.SystemId]:
+			c.ExcludedReason = models.ExcludedAlreadyAssigned
+		case atCapacity(user):
+			c.ExcludedReason = models.ExcludedAtCapacity
+		}
+
+		t.seen[user.SystemId] = c
+		t.candidates = append(t.candidates, c)
+	}
+}
+
+// selected marks the picked user, recording it first if the selection got
+// the user from outside of the considered ones.
+func (t *assignmentTrace) selected(user *models.User, pool string) {
+	if t == nil {
+		return
+	}
+
+	c, ok := t.seen[user.SystemId]
+	if !ok {
+		c = &models.AssignmentCandidate{UserId: user.UserId, SystemId: user.SystemId}
+		t.seen[user.SystemId] = c
+		t.candidates = append(t.candidates, c)
+	}
+
+	c.Selected = true
+	c.Pool = pool
+}
+
+// notifyUsers sends the event to every user in the background, so a slow
+// channel never holds the request. Failures are only logged.
+func (u *UseCase) notifyUsers(ctx context.Context, userIds []int, e notify.Event) {
+	if u.notifier == nil || len(userIds) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notifyTimeout)
+		defer cancel()
+
+		contacts, err := u.repo.GetUserContacts(ctx, userIds)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] notifyUsers", err.Error())
+			return
+		}
+
+		for _, c := range contacts {
+			msg, err := notify.Render(e, c)
+			if err != nil {
+				logs.PrintLog(ctx, "[usecase] notifyUsers", fmt.Sprintf("Render for %+v: %+v", c.SystemId, err))
+				continue
+			}
+
+			if err := u.notifier.Notify(ctx, c, msg); err != nil {
+				logs.PrintLog(ctx, "[usecase] notifyUsers", fmt.Sprintf("Notify %+v: %+v", c.SystemId, err))
+			}
+		}
+	}()
+}
+
+// publishChanges streams reviewer changes of users who left a team or were
+// deactivated.
+func (u *UseCase) publishChanges(changes []*models.ReviewerChange) {
+	for _, c := range changes {
+		e := models.LiveEventDTO{
+			Type:          models.LiveReviewerRemoved,
+			TeamName:      c.TeamName,
+			PullRequestId: c.PullRequestSystemId,
+			AuthorId:      c.AuthorSystemId,
+			OldReviewerId: c.OldReviewer.SystemId,
+		}
+
+		if c.NewReviewer != nil {
+			e.Type = models.LiveReviewerReplaced
+			e.NewReviewerId = c.NewReviewer.SystemId
+		}
+
+		u.events.Publish(e)
+	}
+}
+
+func userSystemIds(users []*models.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.SystemId)
+	}
+	return ids
+}
+
+func (u *UseCase) SubscribeEvents(ctx context.Context, filter *models.LiveEventFilterDTO) *bus.Subscription {
+	sub := u.events.Subscribe(bus.Filter{TeamName: filter.TeamName, UserId: filter.UserId}, filter.LastEventId)
+	logs.PrintLog(ctx, "[usecase] SubscribeEvents", fmt.Sprintf("Subscribed team %+v user %+v, replayed: %+v", filter.TeamName, filter.UserId, len(sub.Missed)))
+	return sub
+}
+
+func userIds(users []*models.User) []int {
+	ids := make([]int, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.UserId)
+	}
+	return ids
+}
+
+// explain stores how reviewers were picked. The assignment itself is already
+// done by then, so a failure is only logged.
+func (u *UseCase) explain(ctx context.Context, t *assignmentTrace, trigger string, seed *int64, replaced *models.User) {
+	explanation := &models.AssignmentExplanation{
+		PullRequestId: t.pr.PullRequestId,
+		Trigger:       trigger,
+		Actor:         actor.FromContext(ctx),
+		Strategy:      t.strategy,
+		Seed:          seed,
+		Candidates:    t.candidates,
+	}
+
+	if replaced != nil {
+		explanation.ReplacedReviewerId = replaced.UserId
+	}
+
+	if err := u.repo.AddAssignmentExplanation(ctx, explanation); err != nil {
+		logs.PrintLog(ctx, "[usecase] explain", err.Error())
+	}
+}
+
+func validateMaxOpenReviews(maxOpenReviews *int) error {
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return appErrors.ErrInvalidCapacity
+	}
+	return nil
+}
+
+// reviewLoadCounter counts open reviews of each user for the least-loaded strategy.
+type reviewLoadCounter struct {
 	repo repository.RepositoryInterface
 }
 
-func NewUseCase(repo repository.RepositoryInterface) *UseCase {
-	return &UseCase{repo: repo}
+func (c *reviewLoadCounter) OpenReviewCounts(ctx context.Context, userIds []int) (map[int]int, error) {
+	return c.repo.GetOpenReviewCounts(ctx, userIds)
 }
 
-func (u *UseCase) AddTeam(ctx context.Context, dto *models.TeamDTO) error {
-	exists, err := u.repo.TeamExists(ctx, dto.TeamName)
+// pairingHistory reads earlier author-reviewer pairings for the least-paired strategy.
+type pairingHistory struct {
+	repo repository.RepositoryInterface
+}
+
+func (h *pairingHistory) Pairings(ctx context.Context, authorId int, userIds []int, since time.Time) (map[int]models.Pairing, error) {
+	return h.repo.GetPairings(ctx, authorId, userIds, since)
+}
+
+// strategyOf returns the strategy reviewers of team are selected with.
+func (u *UseCase) strategyOf(team *models.Team) string {
+	if _, ok := u.selectors[team.ReviewerStrategy]; ok {
+		return team.ReviewerStrategy
+	}
+	return selector.DefaultStrategy
+}
+
+func (u *UseCase) selectReviewers(ctx context.Context, rng *rand.Rand, team *models.Team, pr *models.PullRequest, candidates []*models.User, count int) ([]*models.User, error) {
+	strategy := u.strategyOf(team)
+	if strategy != team.ReviewerStrategy {
+		logs.PrintLog(ctx, "[usecase] selectReviewers", fmt.Sprintf("Unknown strategy %+v, fallback to %+v", team.ReviewerStrategy, strategy))
+	}
+	s := u.selectors[strategy]
+
+	return s.Select(ctx, selector.Request{
+		TeamId:     team.TeamId,
+		Candidates: candidates,
+		Count:      count,
+		AuthorId:   pr.AuthorId,
+		Lookback:   time.Duration(team.PairingLookbackDays) * 24 * time.Hour,
+		Rand:       rng,
+	})
+}
+
+// fileOwners returns active owners of the changed files, except the author,
+// with the pattern of the rule each owner was first matched by. Owners left
+// out are noted in trace with the reason.
+func (u *UseCase) fileOwners(ctx context.Context, trace *assignmentTrace, team *models.Team, authorSystemId string, files []string) ([]*models.User, map[string]string, error) {
+	rules, err := u.repo.GetOwnershipRules(ctx, team.TeamId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
-		return appErrors.ErrServerError
+		return nil, nil, err
 	}
-	if exists {
-		logs.PrintLog(ctx, "[usecase] AddTeam", appErrors.ErrTeamExists.Error())
-		return appErrors.ErrTeamExists
+
+	patterns := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		patterns = append(patterns, rule.Pattern)
 	}
 
-	team := &models.Team{
-		TeamName:    dto.TeamName,
-		TeamMembers: make([]*models.User, 0, len(dto.Members)),
+	matcher, err := codeowners.NewMatcher(patterns)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, m := range dto.Members {
-		user := &models.User{
-			SystemId: m.UserID,
-			UserName: m.Username,
-			IsActive: m.IsActive,
+	var owners []*models.User
+	matchedRules := make(map[string]string)
+	for _, file := range files {
+		i := matcher.Match(file)
+		if i < 0 {
+			continue
 		}
 
-		team.TeamMembers = append(team.TeamMembers, user)
+		for _, owner := range rules[i].Owners {
+			if _, ok := matchedRules[owner.SystemId]; ok {
+				continue
+			}
+
+			if !available(owner) || owner.SystemId == authorSystemId {
+				trace.consider([]*models.User{owner})
+				continue
+			}
+
+			matchedRules[owner.SystemId] = rules[i].Pattern
+			owners = append(owners, owner)
+		}
 	}
 
-	if err := u.repo.CreateTeam(ctx, team); err != nil {
-		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
-		return appErrors.ErrServerError
+	return owners, matchedRules, nil
+}
+
+// normalizeTags lowercases, dedupes and sorts tags. Nil stays nil, so that
+// member updates without tags keep the stored ones.
+func normalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || strings.ContainsAny(tag, " \t,") {
+			return nil, appErrors.ErrInvalidTag
+		}
+
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// hasTags reports whether the user has every one of tags.
+func hasTags(user *models.User, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(user.Tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// selectSkilled selects reviewers among candidates having all required tags
+// of pr with the team strategy and tops up from the rest. When nobody has the tags,
+// reviewers are chosen at random.
+func (u *UseCase) selectSkilled(ctx context.Context, rng *rand.Rand, team *models.Team, pr *models.PullRequest, candidates []*models.User, count int) ([]*models.User, error) {
+	tags := pr.RequiredTags
+	if len(tags) == 0 {
+		return u.selectReviewers(ctx, rng, team, pr, candidates, count)
+	}
+
+	var skilled, rest []*models.User
+	for _, c := range candidates {
+		if hasTags(c, tags) {
+			skilled = append(skilled, c)
+		} else {
+			rest = append(rest, c)
+		}
+	}
+
+	if len(skilled) == 0 {
+		return u.selectors[selector.StrategyRandom].Select(ctx, selector.Request{
+			TeamId:     team.TeamId,
+			Candidates: candidates,
+			Count:      count,
+			Rand:       rng,
+		})
+	}
+
+	reviewers, err := u.selectReviewers(ctx, rng, team, pr, skilled, count)
+	if err != nil || len(reviewers) >= count {
+		return reviewers, err
+	}
+
+	more, err := u.selectReviewers(ctx, rng, team, pr, rest, count-len(reviewers))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reviewers, more...), nil
+}
+
+// pickReviewers selects count reviewers for pr among owners of the changed
+// files, then from the home team candidates and, when they run short, from
+// active members of the partner teams in priority order, each partner using
+// its own strategy. Teammates with the required tags of pr are preferred and
+// users at capacity are skipped. Pools tell where every picked reviewer came
+// from; capped is set when fewer than count reviewers were picked while
+// someone was skipped for capacity. Owners and partner members looked at are
+// noted in trace.
+func (u *UseCase) pickReviewers(ctx context.Context, rng *rand.Rand, trace *assignmentTrace, team *models.Team, pr *models.PullRequest, candidates []*models.User, count int) (reviewers []*models.User, pools []models.ReviewerPoolDTO, capped bool, err error) {
+	reviewers = make([]*models.User, 0, count)
+	pools = make([]models.ReviewerPoolDTO, 0, count)
+	picked := make(map[string]bool, count)
+	skipped := false
+
+	if len(pr.ChangedFiles) > 0 {
+		owners, matchedRules, err := u.fileOwners(ctx, trace, team, pr.AuthorSystemId, pr.ChangedFiles)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		trace.consider(owners)
+
+		var full bool
+		owners, full = withinCapacity(owners)
+		skipped = skipped || full
+
+		selected, err := u.selectReviewers(ctx, rng, team, pr, owners, count)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		for _, r := range selected {
+			picked[r.SystemId] = true
+			reviewers = append(reviewers, r)
+			pools = append(pools, models.ReviewerPoolDTO{ReviewerId: r.SystemId, Pool: models.PoolOwner, MatchedRule: matchedRules[r.SystemId]})
+			trace.selected(r, models.PoolOwner)
+		}
+
+		if len(reviewers) >= count {
+			return reviewers, pools, skipped && len(reviewers) < count, nil
+		}
+	}
+
+	var home []*models.User
+	for _, c := range candidates {
+		if !picked[c.SystemId] {
+			home = append(home, c)
+		}
+	}
+
+	home, full := withinCapacity(home)
+	skipped = skipped || full
+
+	selected, err := u.selectSkilled(ctx, rng, team, pr, home, count-len(reviewers))
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	for _, r := range selected {
+		picked[r.SystemId] = true
+		reviewers = append(reviewers, r)
+		pools = append(pools, models.ReviewerPoolDTO{ReviewerId: r.SystemId, Pool: models.PoolHome, TeamName: team.TeamName})
+		trace.selected(r, models.PoolHome)
+	}
+
+	if len(reviewers) >= count {
+		return reviewers, pools, skipped && len(reviewers) < count, nil
+	}
+
+	partners, err := u.repo.GetPartnerTeams(ctx, team.TeamId)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	for _, partner := range partners {
+		if len(reviewers) >= count {
+			break
+		}
+
+		members, err := u.repo.GetTeamMembers(ctx, partner.TeamId)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		trace.consider(members)
+
+		var partnerCandidates []*models.User
+		for _, m := range members {
+			if available(m) && m.SystemId != pr.AuthorSystemId && !picked[m.SystemId] {
+				partnerCandidates = append(partnerCandidates, m)
+			}
+		}
+
+		partnerCandidates, full := withinCapacity(partnerCandidates)
+		skipped = skipped || full
+
+		borrowed, err := u.selectSkilled(ctx, rng, partner, pr, partnerCandidates, count-len(reviewers))
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		for _, r := range borrowed {
+			picked[r.SystemId] = true
+			reviewers = append(reviewers, r)
+			pools = append(pools, models.ReviewerPoolDTO{ReviewerId: r.SystemId, Pool: models.PoolPartner, TeamName: partner.TeamName})
+			trace.selected(r, models.PoolPartner)
+		}
+	}
+
+	return reviewers, pools, skipped && len(reviewers) < count, nil
+}
+
+// assignEventReason explains how a reviewer from the given pool was picked.
+func assignEventReason(team *models.Team, pool models.ReviewerPoolDTO) string {
+	switch pool.Pool {
+	case models.PoolOwner:
+		return fmt.Sprintf("assigned as owner of %s", pool.MatchedRule)
+	case models.PoolPartner:
+		return fmt.Sprintf("assigned from partner team %s", pool.TeamName)
+	}
+
+	return fmt.Sprintf("assigned by %s strategy", team.ReviewerStrategy)
+}
+
+type transition struct {
+	from []string
+	to   string
+}
+
+// pullRequestTransitions is the PR state machine: for each lifecycle event the
+// statuses it is allowed from and the status it leads to. MERGED is final.
+var pullRequestTransitions = map[string]transition{
+	models.EventPullRequestReady:    {from: []string{models.StatusDraft}, to: models.StatusOpen},
+	models.EventPullRequestMerged:   {from: []string{models.StatusOpen}, to: models.StatusMerged},
+	models.EventPullRequestClosed:   {from: []string{models.StatusDraft, models.StatusOpen}, to: models.StatusClosed},
+	models.EventPullRequestReopened: {from: []string{models.StatusClosed}, to: models.StatusOpen},
+}
+
+// checkTransition returns the status the PR gets after the event.
+func checkTransition(status string, eventType string) (string, error) {
+	t := pullRequestTransitions[eventType]
+	for _, from := range t.from {
+		if from == status {
+			return t.to, nil
+		}
+	}
+
+	return "", &appErrors.StatusTransitionError{From: status, To: t.to}
+}
+
+func (u *UseCase) getTeam(ctx context.Context, teamId int) (*models.Team, error) {
+	team, err := u.repo.GetTeamById(ctx, teamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] getTeam", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if team == nil {
+		logs.PrintLog(ctx, "[usecase] getTeam", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	return team, nil
+}
+
+// pullRequestTeam returns the team whose settings apply to pr. AuthorTeamId
+// already falls back to the author's current team, so a PR without one gets
+// the default settings: no merge policy and reassignment within the author's
+// team, which has no other members.
+func (u *UseCase) pullRequestTeam(ctx context.Context, pr *models.PullRequest) (*models.Team, error) {
+	if pr.AuthorTeamId == 0 {
+		logs.PrintLog(ctx, "[usecase] pullRequestTeam", fmt.Sprintf("Pull request %+v has no author team, using defaults", pr.SystemId))
+		return &models.Team{
+			ReviewerStrategy:    selector.DefaultStrategy,
+			MinReviewers:        defaultMinReviewers,
+			MaxReviewers:        defaultMaxReviewers,
+			PairingLookbackDays: defaultPairingLookbackDays,
+			ReassignPool:        models.ReassignPoolAuthorTeam,
+		}, nil
+	}
+
+	return u.getTeam(ctx, pr.AuthorTeamId)
+}
+
+// replacementCandidates returns active members who may take a review slot of pr:
+// not the author, not already assigned and not explicitly excluded.
+func replacementCandidates(members []*models.User, pr *models.PullRequest, excluded map[string]bool) []*models.User {
+	assigned := make(map[string]bool, len(pr.AssigneeReviewers))
+	for _, r := range pr.AssigneeReviewers {
+		assigned[r.SystemId] = true
+	}
+
+	var candidates []*models.User
+	for _, member := range members {
+		if member.SystemId == pr.AuthorSystemId || assigned[member.SystemId] || excluded[member.SystemId] {
+			continue
+		}
+		if available(member) {
+			candidates = append(candidates, member)
+		}
+	}
+
+	return candidates
+}
+
+// replacementPool caches teams and their members across the replacements of
+// one operation, so later picks see the reviews given by earlier ones.
+// Excluded users are never picked.
+type replacementPool struct {
+	teams    map[int]*models.Team
+	members  map[int][]*models.User
+	excluded map[string]bool
+}
+
+func newReplacementPool(excluded []*models.User) *replacementPool {
+	pool := &replacementPool{
+		teams:    make(map[int]*models.Team),
+		members:  make(map[int][]*models.User),
+		excluded: make(map[string]bool, len(excluded)),
+	}
+	for _, user := range excluded {
+		pool.excluded[user.SystemId] = true
+	}
+	return pool
+}
+
+func (u *UseCase) poolTeam(ctx context.Context, pool *replacementPool, teamId int) (*models.Team, error) {
+	if team, ok := pool.teams[teamId]; ok {
+		return team, nil
+	}
+
+	team, err := u.getTeam(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.teams[teamId] = team
+	return team, nil
+}
+
+func (u *UseCase) poolMembers(ctx context.Context, pool *replacementPool, teamId int) ([]*models.User, error) {
+	if members, ok := pool.members[teamId]; ok {
+		return members, nil
+	}
+
+	// the default team of a PR without an author team has no members
+	if teamId == 0 {
+		return nil, nil
+	}
+
+	members, err := u.repo.GetTeamMembers(ctx, teamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] poolMembers", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	pool.members[teamId] = members
+	return members, nil
+}
+
+// planReviewReplacements finds a replacement for every open review held by the
+// given users, from the reassign pool of each PR's author team as Reassign does.
+// Nothing is written: the caller applies the returned changes together with its
+// own update.
+func (u *UseCase) planReviewReplacements(ctx context.Context, users []*models.User) ([]*models.ReviewerChange, error) {
+	pool := newReplacementPool(users)
+
+	prs := make(map[string]*models.PullRequest)
+	changes := make([]*models.ReviewerChange, 0)
+
+	for _, user := range users {
+		reviews, err := u.repo.GetListReviewsByUserId(ctx, user.UserId)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] planReviewReplacements", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		for _, review := range reviews {
+			if review.Status != models.StatusOpen {
+				continue
+			}
+
+			pr, ok := prs[review.SystemId]
+			if !ok {
+				pr, err = u.repo.GetPullRequestById(ctx, review.SystemId)
+				if err != nil {
+					logs.PrintLog(ctx, "[usecase] planReviewReplacements", err.Error())
+					return nil, appErrors.ErrServerError
+				}
+				if pr == nil {
+					continue
+				}
+				prs[review.SystemId] = pr
+			}
+
+			change := &models.ReviewerChange{
+				PullRequestId:       pr.PullRequestId,
+				PullRequestSystemId: pr.SystemId,
+				AuthorSystemId:      pr.AuthorSystemId,
+				OldReviewer:         user,
+			}
+
+			if pr.AuthorTeamId != 0 {
+				authorTeam, err := u.poolTeam(ctx, pool, pr.AuthorTeamId)
+				if err != nil {
+					return nil, err
+				}
+				change.TeamName = authorTeam.TeamName
+
+				// every pick gets its own seed, so its event replays on its own
+				seed, rng := u.newRand()
+				change.NewReviewer, _, err = u.pickReplacement(ctx, rng, nil, pool, pr, user, authorTeam)
+				if err != nil {
+					return nil, err
+				}
+
+				if change.NewReviewer != nil {
+					change.Seed = &seed
+					// members are cached, later picks must see the new load
+					change.NewReviewer.OpenReviews++
+				}
+			} else {
+				logs.PrintLog(ctx, "[usecase] planReviewReplacements", fmt.Sprintf("Pull request %+v has no author team", pr.SystemId))
+			}
+
+			// keep the cached PR in sync, so the next replacement sees this one
+			reviewers := make([]*models.User, 0, len(pr.AssigneeReviewers))
+			for _, r := range pr.AssigneeReviewers {
+				if r.SystemId != user.SystemId {
+					reviewers = append(reviewers, r)
+				}
+			}
+			if change.NewReviewer != nil {
+				reviewers = append(reviewers, change.NewReviewer)
+			}
+			pr.AssigneeReviewers = reviewers
+			change.ReviewersLeft = len(reviewers)
+
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+// changeEvents builds history records for reviewer changes made on behalf of the caller.
+func changeEvents(ctx context.Context, changes []*models.ReviewerChange, reason string) []*models.PrEvent {
+	events := make([]*models.PrEvent, 0, len(changes))
+	for _, c := range changes {
+		e := &models.PrEvent{
+			PullRequestId: c.PullRequestId,
+			EventType:     models.EventReviewerRemoved,
+			Actor:         actor.FromContext(ctx),
+			OldReviewerId: c.OldReviewer.UserId,
+			Reason:        reason,
+		}
+
+		if c.NewReviewer != nil {
+			e.EventType = models.EventReviewerReplaced
+			e.NewReviewerId = c.NewReviewer.UserId
+			e.Seed = c.Seed
+		}
+
+		events = append(events, e)
+	}
+	return events
+}
+
+func reviewerChangesToDto(changes []*models.ReviewerChange) []models.ReviewerChangeDTO {
+	out := make([]models.ReviewerChangeDTO, 0, len(changes))
+	for _, c := range changes {
+		replacedBy := "-"
+		if c.NewReviewer != nil {
+			replacedBy = c.NewReviewer.SystemId
+		}
+
+		out = append(out, models.ReviewerChangeDTO{
+			PullRequestId: c.PullRequestSystemId,
+			OldReviewerId: c.OldReviewer.SystemId,
+			ReplacedBy:    replacedBy,
+		})
+	}
+	return out
+}
+
+func validateReviewsPolicy(policy string) (string, error) {
+	switch policy {
+	case "":
+		return models.ReviewsPolicyKeep, nil
+	case models.ReviewsPolicyKeep, models.ReviewsPolicyReassign:
+		return policy, nil
+	default:
+		return "", appErrors.ErrUnknownReviewsPolicy
+	}
+}
+
+func validateReassignPool(pool string) error {
+	switch pool {
+	case models.ReassignPoolAuthorTeam, models.ReassignPoolReviewerTeam, models.ReassignPoolUnion:
+		return nil
+	default:
+		return appErrors.ErrUnknownReassignPool
+	}
+}
+
+func validatePairingLookback(days int) error {
+	if days < 1 {
+		return appErrors.ErrInvalidPairingLookback
+	}
+	return nil
+}
+
+func validateReviewersCount(minReviewers, maxReviewers int) error {
+	if minReviewers < 0 || maxReviewers < minReviewers {
+		return appErrors.ErrInvalidReviewersCount
+	}
+	return nil
+}
+
+func validateRequiredApprovals(requiredApprovals, maxReviewers int) error {
+	if requiredApprovals < 0 || requiredApprovals > maxReviewers {
+		return appErrors.ErrInvalidRequiredApprovals
+	}
+	return nil
+}
+
+func (u *UseCase) AddTeam(ctx context.Context, dto *models.TeamDTO) error {
+	strategy := dto.ReviewerStrategy
+	if strategy == "" {
+		strategy = selector.DefaultStrategy
+	}
+
+	if _, ok := u.selectors[strategy]; !ok {
+		logs.PrintLog(ctx, "[usecase] AddTeam", appErrors.ErrUnknownStrategy.Error())
+		return appErrors.ErrUnknownStrategy
+	}
+
+	minReviewers, maxReviewers := defaultMinReviewers, defaultMaxReviewers
+	if dto.MinReviewers != nil {
+		minReviewers = *dto.MinReviewers
+	}
+	if dto.MaxReviewers != nil {
+		maxReviewers = *dto.MaxReviewers
+	}
+
+	if err := validateReviewersCount(minReviewers, maxReviewers); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return err
+	}
+
+	reassignPool := dto.ReassignPool
+	if reassignPool == "" {
+		reassignPool = models.ReassignPoolAuthorTeam
+	}
+
+	if err := validateReassignPool(reassignPool); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return err
+	}
+
+	pairingLookbackDays := defaultPairingLookbackDays
+	if dto.PairingLookbackDays != nil {
+		pairingLookbackDays = *dto.PairingLookbackDays
+	}
+
+	if err := validatePairingLookback(pairingLookbackDays); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return err
+	}
+
+	requiredApprovals := 0
+	if dto.RequiredApprovals != nil {
+		requiredApprovals = *dto.RequiredApprovals
+	}
+
+	if err := validateRequiredApprovals(requiredApprovals, maxReviewers); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return err
+	}
+
+	exists, err := u.repo.TeamExists(ctx, dto.TeamName)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return appErrors.ErrServerError
+	}
+	if exists {
+		logs.PrintLog(ctx, "[usecase] AddTeam", appErrors.ErrTeamExists.Error())
+		return appErrors.ErrTeamExists
+	}
+
+	team := &models.Team{
+		TeamName:            dto.TeamName,
+		ReviewerStrategy:    strategy,
+		MinReviewers:        minReviewers,
+		MaxReviewers:        maxReviewers,
+		RequiredApprovals:   requiredApprovals,
+		ReassignPool:        reassignPool,
+		PairingLookbackDays: pairingLookbackDays,
+		TeamMembers:         make([]*models.User, 0, len(dto.Members)),
+	}
+
+	if dto.MergePolicy != nil {
+		team.MergePolicy = models.MergePolicy{
+			RequireReviewer:    dto.MergePolicy.RequireReviewer,
+			NoChangesRequested: dto.MergePolicy.NoChangesRequested,
+			NoSelfApproval:     dto.MergePolicy.NoSelfApproval,
+		}
+	}
+
+	for _, m := range dto.Members {
+		weight := m.ReviewWeight
+		if weight <= 0 {
+			weight = 1
+		}
+
+		tags, err := normalizeTags(m.Tags)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+			return err
+		}
+
+		if err := validateMaxOpenReviews(m.MaxOpenReviews); err != nil {
+			logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+			return err
+		}
+
+		user := &models.User{
+			SystemId:       m.UserID,
+			UserName:       m.Username,
+			IsActive:       m.IsActive,
+			ReviewWeight:   weight,
+			Tags:           tags,
+			MaxOpenReviews: m.MaxOpenReviews,
+		}
+
+		team.TeamMembers = append(team.TeamMembers, user)
+	}
+
+	if err := u.repo.CreateTeam(ctx, team); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddTeam", err.Error())
+		return appErrors.ErrServerError
+	}
+
+	logs.PrintLog(ctx, "[usecase] AddTeam", fmt.Sprintf("Team added: %+v", team.TeamName))
+	return nil
+}
+
+func (u *UseCase) GetTeamByName(ctx context.Context, teamName string) (*models.TeamDTO, error) {
+	team, err := u.repo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetTeamByName", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if team == nil {
+		logs.PrintLog(ctx, "[usecase] GetTeamByName", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] GetTeamByName", fmt.Sprintf("Team found: %+v", team.TeamName))
+
+	teamDto := &models.TeamDTO{
+		TeamName:          team.TeamName,
+		ReviewerStrategy:  team.ReviewerStrategy,
+		MinReviewers:      &team.MinReviewers,
+		MaxReviewers:      &team.MaxReviewers,
+		RequiredApprovals: &team.RequiredApprovals,
+		MergePolicy: &models.MergePolicyDTO{
+			RequireReviewer:    team.MergePolicy.RequireReviewer,
+			NoChangesRequested: team.MergePolicy.NoChangesRequested,
+			NoSelfApproval:     team.MergePolicy.NoSelfApproval,
+		},
+		ReassignPool:        team.ReassignPool,
+		PairingLookbackDays: &team.PairingLookbackDays,
+		PartnerTeams:        team.PartnerTeams,
+		Members:             make([]models.MemberDTO, 0, len(team.TeamMembers)),
+	}
+
+	for _, m := range team.TeamMembers {
+		memberDTO := models.MemberDTO{
+			UserID:         m.SystemId,
+			Username:       m.UserName,
+			IsActive:       m.IsActive,
+			ReviewWeight:   m.ReviewWeight,
+			Tags:           m.Tags,
+			MaxOpenReviews: m.MaxOpenReviews,
+			OpenReviews:    &m.OpenReviews,
+		}
+
+		teamDto.Members = append(teamDto.Members, memberDTO)
+	}
+	return teamDto, nil
+}
+
+func (u *UseCase) SetReviewerStrategy(ctx context.Context, dto *models.SetReviewerStrategyDTO) (*models.TeamDTO, error) {
+	if _, ok := u.selectors[dto.ReviewerStrategy]; !ok {
+		logs.PrintLog(ctx, "[usecase] SetReviewerStrategy", appErrors.ErrUnknownStrategy.Error())
+		return nil, appErrors.ErrUnknownStrategy
+	}
+
+	updated, err := u.repo.SetTeamReviewerStrategy(ctx, dto.TeamName, dto.ReviewerStrategy)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetReviewerStrategy", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetReviewerStrategy", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetReviewerStrategy", fmt.Sprintf("Team %+v uses strategy: %+v", dto.TeamName, dto.ReviewerStrategy))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+func (u *UseCase) SetReviewersCount(ctx context.Context, dto *models.SetReviewersCountDTO) (*models.TeamDTO, error) {
+	if err := validateReviewersCount(dto.MinReviewers, dto.MaxReviewers); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetReviewersCount", err.Error())
+		return nil, err
+	}
+
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the approvals a team requires must stay reachable
+	if validateRequiredApprovals(team.RequiredApprovals, dto.MaxReviewers) != nil {
+		logs.PrintLog(ctx, "[usecase] SetReviewersCount", fmt.Sprintf("Max reviewers %+v below required approvals %+v", dto.MaxReviewers, team.RequiredApprovals))
+		return nil, appErrors.ErrInvalidReviewersCount
+	}
+
+	updated, err := u.repo.SetTeamReviewersCount(ctx, dto.TeamName, dto.MinReviewers, dto.MaxReviewers)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetReviewersCount", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetReviewersCount", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetReviewersCount", fmt.Sprintf("Team %+v reviewers count: %+v-%+v", dto.TeamName, dto.MinReviewers, dto.MaxReviewers))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+func (u *UseCase) SetRequiredApprovals(ctx context.Context, dto *models.SetRequiredApprovalsDTO) (*models.TeamDTO, error) {
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRequiredApprovals(dto.RequiredApprovals, team.MaxReviewers); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetRequiredApprovals", err.Error())
+		return nil, err
+	}
+
+	updated, err := u.repo.SetTeamRequiredApprovals(ctx, dto.TeamName, dto.RequiredApprovals)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetRequiredApprovals", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetRequiredApprovals", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetRequiredApprovals", fmt.Sprintf("Team %+v requires approvals: %+v", dto.TeamName, dto.RequiredApprovals))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+func (u *UseCase) SetMergePolicy(ctx context.Context, dto *models.SetMergePolicyDTO) (*models.TeamDTO, error) {
+	policy := models.MergePolicy{
+		RequireReviewer:    dto.MergePolicy.RequireReviewer,
+		NoChangesRequested: dto.MergePolicy.NoChangesRequested,
+		NoSelfApproval:     dto.MergePolicy.NoSelfApproval,
+	}
+
+	updated, err := u.repo.SetTeamMergePolicy(ctx, dto.TeamName, policy)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetMergePolicy", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetMergePolicy", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetMergePolicy", fmt.Sprintf("Team %+v merge policy: %+v", dto.TeamName, policy))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+func (u *UseCase) SetReassignPool(ctx context.Context, dto *models.SetReassignPoolDTO) (*models.TeamDTO, error) {
+	if err := validateReassignPool(dto.ReassignPool); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetReassignPool", err.Error())
+		return nil, err
+	}
+
+	updated, err := u.repo.SetTeamReassignPool(ctx, dto.TeamName, dto.ReassignPool)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetReassignPool", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetReassignPool", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetReassignPool", fmt.Sprintf("Team %+v reassign pool: %+v", dto.TeamName, dto.ReassignPool))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+// SetPairingLookback sets how many days back the least_paired strategy looks
+// for reviews of the author.
+func (u *UseCase) SetPairingLookback(ctx context.Context, dto *models.SetPairingLookbackDTO) (*models.TeamDTO, error) {
+	if err := validatePairingLookback(dto.PairingLookbackDays); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetPairingLookback", err.Error())
+		return nil, err
+	}
+
+	updated, err := u.repo.SetTeamPairingLookback(ctx, dto.TeamName, dto.PairingLookbackDays)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetPairingLookback", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] SetPairingLookback", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetPairingLookback", fmt.Sprintf("Team %+v pairing lookback: %+v days", dto.TeamName, dto.PairingLookbackDays))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+// SetPartnerTeams replaces the partner teams of a team; their order is the
+// order partners are asked for reviewers.
+func (u *UseCase) SetPartnerTeams(ctx context.Context, dto *models.SetPartnerTeamsDTO) (*models.TeamDTO, error) {
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	partnerIds := make([]int, 0, len(dto.PartnerTeams))
+	seen := make(map[string]bool, len(dto.PartnerTeams))
+	for _, name := range dto.PartnerTeams {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if name == team.TeamName {
+			logs.PrintLog(ctx, "[usecase] SetPartnerTeams", appErrors.ErrInvalidPartnerTeam.Error())
+			return nil, appErrors.ErrInvalidPartnerTeam
+		}
+
+		partner, err := u.findTeam(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		partnerIds = append(partnerIds, partner.TeamId)
+	}
+
+	if err := u.repo.SetTeamPartners(ctx, team.TeamId, partnerIds); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetPartnerTeams", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetPartnerTeams", fmt.Sprintf("Team %+v partners: %+v", dto.TeamName, dto.PartnerTeams))
+	return u.GetTeamByName(ctx, dto.TeamName)
+}
+
+// ruleOwners resolves owner system ids of an ownership rule.
+func (u *UseCase) ruleOwners(ctx context.Context, systemIds []string) ([]*models.User, error) {
+	owners := make([]*models.User, 0, len(systemIds))
+	for _, systemId := range systemIds {
+		owner, err := u.repo.GetUserBySystemId(ctx, systemId)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] ruleOwners", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		if owner == nil {
+			logs.PrintLog(ctx, "[usecase] ruleOwners", fmt.Sprintf("User not found: %+v", systemId))
+			return nil, appErrors.ErrResourceNotFound
+		}
+
+		owners = append(owners, owner)
+	}
+
+	return owners, nil
+}
+
+func ownershipRuleDto(rule *models.OwnershipRule, teamName string) models.OwnershipRuleDTO {
+	ruleDto := models.OwnershipRuleDTO{
+		RuleId:   rule.RuleId,
+		TeamName: teamName,
+		Pattern:  rule.Pattern,
+		Owners:   make([]string, 0, len(rule.Owners)),
+	}
+
+	for _, owner := range rule.Owners {
+		ruleDto.Owners = append(ruleDto.Owners, owner.SystemId)
+	}
+
+	return ruleDto
+}
+
+// AddOwnershipRule appends a rule to the team rules, so it takes precedence
+// over every rule added before it.
+func (u *UseCase) AddOwnershipRule(ctx context.Context, dto *models.OwnershipRuleDTO) (*models.OwnershipRuleDTO, error) {
+	if _, err := codeowners.Compile(dto.Pattern); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddOwnershipRule", err.Error())
+		return nil, appErrors.ErrInvalidOwnershipPattern
+	}
+
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := u.ruleOwners(ctx, dto.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &models.OwnershipRule{
+		TeamId:  team.TeamId,
+		Pattern: dto.Pattern,
+		Owners:  owners,
+	}
+
+	if err := u.repo.CreateOwnershipRule(ctx, rule); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddOwnershipRule", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	logs.PrintLog(ctx, "[usecase] AddOwnershipRule", fmt.Sprintf("Team %+v rule %+v: %+v", team.TeamName, rule.RuleId, rule.Pattern))
+	ruleDto := ownershipRuleDto(rule, team.TeamName)
+	return &ruleDto, nil
+}
+
+func (u *UseCase) GetOwnershipRules(ctx context.Context, teamName string) (*models.OwnershipRulesDTO, error) {
+	team, err := u.findTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.repo.GetOwnershipRules(ctx, team.TeamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetOwnershipRules", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	rulesDto := &models.OwnershipRulesDTO{
+		TeamName: team.TeamName,
+		Rules:    make([]models.OwnershipRuleDTO, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		rulesDto.Rules = append(rulesDto.Rules, ownershipRuleDto(rule, team.TeamName))
+	}
+
+	return rulesDto, nil
+}
+
+// UpdateOwnershipRule replaces the pattern and owners of a rule; the rule stays
+// in its team at the same position.
+func (u *UseCase) UpdateOwnershipRule(ctx context.Context, dto *models.OwnershipRuleDTO) (*models.OwnershipRuleDTO, error) {
+	if _, err := codeowners.Compile(dto.Pattern); err != nil {
+		logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", err.Error())
+		return nil, appErrors.ErrInvalidOwnershipPattern
+	}
+
+	rule, err := u.repo.GetOwnershipRuleById(ctx, dto.RuleId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if rule == nil {
+		logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	team, err := u.getTeam(ctx, rule.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := u.ruleOwners(ctx, dto.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Pattern = dto.Pattern
+	rule.Owners = owners
+
+	updated, err := u.repo.UpdateOwnershipRule(ctx, rule)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !updated {
+		logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] UpdateOwnershipRule", fmt.Sprintf("Team %+v rule %+v: %+v", team.TeamName, rule.RuleId, rule.Pattern))
+	ruleDto := ownershipRuleDto(rule, team.TeamName)
+	return &ruleDto, nil
+}
+
+func (u *UseCase) DeleteOwnershipRule(ctx context.Context, dto *models.DeleteOwnershipRuleDTO) error {
+	deleted, err := u.repo.DeleteOwnershipRule(ctx, dto.RuleId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] DeleteOwnershipRule", err.Error())
+		return appErrors.ErrServerError
+	}
+
+	if !deleted {
+		logs.PrintLog(ctx, "[usecase] DeleteOwnershipRule", appErrors.ErrResourceNotFound.Error())
+		return appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] DeleteOwnershipRule", fmt.Sprintf("Rule deleted: %+v", dto.RuleId))
+	return nil
+}
+
+// applyMembership writes membership changes for team and, with the reassign
+// policy, moves open reviews of the leaving users to the reassign pool of
+// each PR.
+func (u *UseCase) applyMembership(ctx context.Context, team *models.Team, upserts []*models.User, removed []*models.User, leaving []*models.User, policy string) (*models.TeamMembersUpdatedDTO, error) {
+	var changes []*models.ReviewerChange
+	if policy == models.ReviewsPolicyReassign {
+		var err error
+		changes, err = u.planReviewReplacements(ctx, leaving)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	removeIds := make([]int, 0, len(removed))
+	for _, m := range removed {
+		removeIds = append(removeIds, m.UserId)
+	}
+
+	events := changeEvents(ctx, changes, "reviewer left the team")
+
+	if err := u.repo.UpdateTeamMembers(ctx, team.TeamId, upserts, removeIds, changes, events); err != nil {
+		logs.PrintLog(ctx, "[usecase] applyMembership", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	u.publishChanges(changes)
+
+	teamDto, err := u.GetTeamByName(ctx, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TeamMembersUpdatedDTO{
+		Team:       *teamDto,
+		Reassigned: reviewerChangesToDto(changes),
+	}, nil
+}
+
+func (u *UseCase) findTeam(ctx context.Context, teamName string) (*models.Team, error) {
+	team, err := u.repo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] findTeam", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if team == nil {
+		logs.PrintLog(ctx, "[usecase] findTeam", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	return team, nil
+}
+
+func (u *UseCase) UpdateTeamMembers(ctx context.Context, dto *models.UpdateTeamMembersDTO) (*models.TeamMembersUpdatedDTO, error) {
+	policy, err := validateReviewsPolicy(dto.ReviewsPolicy)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] UpdateTeamMembers", err.Error())
+		return nil, err
+	}
+
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	upserts := make([]*models.User, 0, len(dto.Members))
+	var leaving []*models.User
+	for _, m := range dto.Members {
+		existing, err := u.repo.GetUserBySystemId(ctx, m.UserID)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] UpdateTeamMembers", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		if existing != nil && existing.TeamId != 0 && existing.TeamId != team.TeamId {
+			leaving = append(leaving, existing)
+		}
+
+		tags, err := normalizeTags(m.Tags)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] UpdateTeamMembers", err.Error())
+			return nil, err
+		}
+
+		if err := validateMaxOpenReviews(m.MaxOpenReviews); err != nil {
+			logs.PrintLog(ctx, "[usecase] UpdateTeamMembers", err.Error())
+			return nil, err
+		}
+
+		upserts = append(upserts, &models.User{
+			SystemId:       m.UserID,
+			UserName:       m.Username,
+			IsActive:       m.IsActive,
+			ReviewWeight:   m.ReviewWeight,
+			Tags:           tags,
+			MaxOpenReviews: m.MaxOpenReviews,
+		})
+	}
+
+	result, err := u.applyMembership(ctx, team, upserts, nil, leaving, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.PrintLog(ctx, "[usecase] UpdateTeamMembers", fmt.Sprintf("Team %+v members updated: %+v, moved from other teams: %+v", team.TeamName, len(upserts), len(leaving)))
+	return result, nil
+}
+
+func (u *UseCase) RemoveTeamMembers(ctx context.Context, dto *models.RemoveTeamMembersDTO) (*models.TeamMembersUpdatedDTO, error) {
+	policy, err := validateReviewsPolicy(dto.ReviewsPolicy)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] RemoveTeamMembers", err.Error())
+		return nil, err
+	}
+
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	bySystemId := make(map[string]*models.User, len(team.TeamMembers))
+	for _, m := range team.TeamMembers {
+		bySystemId[m.SystemId] = m
+	}
+
+	removed := make([]*models.User, 0, len(dto.UserIds))
+	for _, userId := range dto.UserIds {
+		member, ok := bySystemId[userId]
+		if !ok {
+			logs.PrintLog(ctx, "[usecase] RemoveTeamMembers", fmt.Sprintf("User %+v is not a member of team %+v", userId, team.TeamName))
+			return nil, appErrors.ErrResourceNotFound
+		}
+		removed = append(removed, member)
+	}
+
+	result, err := u.applyMembership(ctx, team, nil, removed, removed, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.PrintLog(ctx, "[usecase] RemoveTeamMembers", fmt.Sprintf("Team %+v members removed: %+v", team.TeamName, len(removed)))
+	return result, nil
+}
+
+func (u *UseCase) MoveUser(ctx context.Context, dto *models.MoveUserDTO) (*models.TeamMembersUpdatedDTO, error) {
+	policy, err := validateReviewsPolicy(dto.ReviewsPolicy)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MoveUser", err.Error())
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MoveUser", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] MoveUser", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	team, err := u.findTeam(ctx, dto.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var leaving []*models.User
+	if user.TeamId != 0 && user.TeamId != team.TeamId {
+		leaving = append(leaving, user)
+	}
+
+	moved := &models.User{
+		SystemId:     user.SystemId,
+		UserName:     user.UserName,
+		IsActive:     user.IsActive,
+		ReviewWeight: user.ReviewWeight,
+	}
+
+	result, err := u.applyMembership(ctx, team, []*models.User{moved}, nil, leaving, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.PrintLog(ctx, "[usecase] MoveUser", fmt.Sprintf("User %+v moved to team %+v", user.SystemId, team.TeamName))
+	return result, nil
+}
+
+func (u *UseCase) SetIsActive(ctx context.Context, dto *models.SetIsActiveDTO) (*models.UserDTO, error) {
+	user, err := u.repo.SetIsActive(ctx, dto.UserID, dto.IsActive)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetIsActive", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] SetIsActive", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	u.events.Publish(models.LiveEventDTO{
+		Type:     models.LiveUserActivity,
+		TeamName: user.TeamName,
+		UserId:   user.SystemId,
+		IsActive: &user.IsActive,
+	})
+
+	logs.PrintLog(ctx, "[usecase] SetIsActive", fmt.Sprintf("Member updated: %+v set isActive to: %+v", dto.UserID, dto.IsActive))
+	return userToDto(user), nil
+}
+
+func userToDto(user *models.User) *models.UserDTO {
+	userDto := &models.UserDTO{
+		UserId:         user.SystemId,
+		UserName:       user.UserName,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		Tags:           user.Tags,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+
+	if userDto.Tags == nil {
+		userDto.Tags = make([]string, 0)
+	}
+
+	return userDto
+}
+
+func (u *UseCase) storeUserTags(ctx context.Context, systemId string, tags []string) (*models.UserDTO, error) {
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+
+	user, err := u.repo.SetUserTags(ctx, systemId, tags)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] storeUserTags", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] storeUserTags", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] storeUserTags", fmt.Sprintf("User %+v tags: %+v", systemId, user.Tags))
+	return userToDto(user), nil
+}
+
+// changeUserTags applies change to the current tags of a user.
+func (u *UseCase) changeUserTags(ctx context.Context, dto *models.UserTagsDTO, change func(current, tags []string) []string) (*models.UserDTO, error) {
+	tags, err := normalizeTags(dto.Tags)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] changeUserTags", err.Error())
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] changeUserTags", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] changeUserTags", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	tags, _ = normalizeTags(change(user.Tags, tags))
+	return u.storeUserTags(ctx, dto.UserId, tags)
+}
+
+func (u *UseCase) SetUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error) {
+	tags, err := normalizeTags(dto.Tags)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetUserTags", err.Error())
+		return nil, err
+	}
+
+	return u.storeUserTags(ctx, dto.UserId, tags)
+}
+
+func (u *UseCase) AddUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error) {
+	return u.changeUserTags(ctx, dto, func(current, tags []string) []string {
+		return append(slices.Clone(current), tags...)
+	})
+}
+
+func (u *UseCase) RemoveUserTags(ctx context.Context, dto *models.UserTagsDTO) (*models.UserDTO, error) {
+	return u.changeUserTags(ctx, dto, func(current, tags []string) []string {
+		left := make([]string, 0, len(current))
+		for _, tag := range current {
+			if !slices.Contains(tags, tag) {
+				left = append(left, tag)
+			}
+		}
+		return left
+	})
+}
+
+func (u *UseCase) SetMaxOpenReviews(ctx context.Context, dto *models.SetMaxOpenReviewsDTO) (*models.UserDTO, error) {
+	if err := validateMaxOpenReviews(dto.MaxOpenReviews); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetMaxOpenReviews", err.Error())
+		return nil, err
+	}
+
+	user, err := u.repo.SetUserMaxOpenReviews(ctx, dto.UserId, dto.MaxOpenReviews)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetMaxOpenReviews", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] SetMaxOpenReviews", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetMaxOpenReviews", fmt.Sprintf("User %+v holds %+v open reviews", dto.UserId, user.OpenReviews))
+	return userToDto(user), nil
+}
+
+func validateContacts(dto *models.UserContactsDTO) error {
+	if dto.SlackWebhookUrl != "" {
+		target, err := url.Parse(dto.SlackWebhookUrl)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return appErrors.ErrInvalidContact
+		}
+	}
+
+	if dto.Email != "" {
+		address, err := mail.ParseAddress(dto.Email)
+		if err != nil || address.Address != dto.Email {
+			return appErrors.ErrInvalidContact
+		}
+	}
+
+	return nil
+}
+
+func (u *UseCase) SetContacts(ctx context.Context, dto *models.UserContactsDTO) (*models.UserContactsDTO, error) {
+	dto.SlackWebhookUrl = strings.TrimSpace(dto.SlackWebhookUrl)
+	dto.Email = strings.TrimSpace(dto.Email)
+
+	if err := validateContacts(dto); err != nil {
+		logs.PrintLog(ctx, "[usecase] SetContacts", err.Error())
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetContacts", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] SetContacts", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	err = u.repo.SetUserContacts(ctx, &models.Contact{
+		UserId:          user.UserId,
+		SlackWebhookUrl: dto.SlackWebhookUrl,
+		Email:           dto.Email,
+	})
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SetContacts", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	logs.PrintLog(ctx, "[usecase] SetContacts", fmt.Sprintf("Contacts set for user %+v", dto.UserId))
+	return &models.UserContactsDTO{
+		UserId:          user.SystemId,
+		SlackWebhookUrl: dto.SlackWebhookUrl,
+		Email:           dto.Email,
+	}, nil
+}
+
+func (u *UseCase) GetContacts(ctx context.Context, userSystemId string) (*models.UserContactsDTO, error) {
+	user, err := u.repo.GetUserBySystemId(ctx, userSystemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetContacts", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] GetContacts", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	contacts, err := u.repo.GetUserContacts(ctx, []int{user.UserId})
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetContacts", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	dto := &models.UserContactsDTO{UserId: user.SystemId}
+	if len(contacts) > 0 {
+		dto.SlackWebhookUrl = contacts[0].SlackWebhookUrl
+		dto.Email = contacts[0].Email
+	}
+
+	return dto, nil
+}
+
+func unavailabilityToDto(period *models.Unavailability) models.UnavailabilityDTO {
+	return models.UnavailabilityDTO{
+		PeriodId: period.PeriodId,
+		UserId:   period.User.SystemId,
+		StartsAt: period.StartsAt.Format(time.RFC3339),
+		EndsAt:   period.EndsAt.Format(time.RFC3339),
+		Reason:   period.Reason,
+	}
+}
+
+func (u *UseCase) AddUnavailability(ctx context.Context, dto *models.UnavailabilityDTO) (*models.UnavailabilityDTO, error) {
+	startsAt, err := time.Parse(time.RFC3339, dto.StartsAt)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", err.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, dto.EndsAt)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", err.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	if !endsAt.After(startsAt) {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", appErrors.ErrInvalidPeriod.Error())
+		return nil, appErrors.ErrInvalidPeriod
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	period := &models.Unavailability{
+		User:     user,
+		StartsAt: startsAt.UTC(),
+		EndsAt:   endsAt.UTC(),
+		Reason:   strings.TrimSpace(dto.Reason),
+	}
+
+	if err := u.repo.AddUnavailability(ctx, period); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddUnavailability", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	logs.PrintLog(ctx, "[usecase] AddUnavailability", fmt.Sprintf("User %+v unavailable from %+v to %+v", dto.UserId, period.StartsAt, period.EndsAt))
+	periodDto := unavailabilityToDto(period)
+	return &periodDto, nil
+}
+
+func (u *UseCase) GetUnavailability(ctx context.Context, userSystemId string) (*models.UserUnavailabilityDTO, error) {
+	user, err := u.repo.GetUserBySystemId(ctx, userSystemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetUnavailability", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] GetUnavailability", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	periods, err := u.repo.GetUserUnavailability(ctx, user.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetUnavailability", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	result := &models.UserUnavailabilityDTO{
+		UserId:  userSystemId,
+		Periods: make([]models.UnavailabilityDTO, 0, len(periods)),
+	}
+
+	for _, period := range periods {
+		result.Periods = append(result.Periods, unavailabilityToDto(period))
+	}
+
+	return result, nil
+}
+
+func (u *UseCase) RemoveUnavailability(ctx context.Context, dto *models.RemoveUnavailabilityDTO) error {
+	deleted, err := u.repo.DeleteUnavailability(ctx, dto.PeriodId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] RemoveUnavailability", err.Error())
+		return appErrors.ErrServerError
+	}
+
+	if !deleted {
+		logs.PrintLog(ctx, "[usecase] RemoveUnavailability", appErrors.ErrResourceNotFound.Error())
+		return appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] RemoveUnavailability", fmt.Sprintf("Period removed: %+v", dto.PeriodId))
+	return nil
+}
+
+// ReassignUnavailableReviews moves open reviews away from users whose
+// unavailability period has started. A period is marked handled once all of
+// its reviews moved; reviews nobody can take stay in place and the period is
+// retried on the next run. A failing period does not stop the others.
+func (u *UseCase) ReassignUnavailableReviews(ctx context.Context) error {
+	ctx = actor.WithActor(ctx, "unavailability-job")
+
+	periods, err := u.repo.GetStartedUnavailability(ctx)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] ReassignUnavailableReviews", err.Error())
+		return appErrors.ErrServerError
+	}
+
+	var failed error
+	for _, period := range periods {
+		handled, err := u.reassignUnavailability(ctx, period)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] ReassignUnavailableReviews", fmt.Sprintf("Period %+v of %+v: %+v", period.PeriodId, period.User.SystemId, err))
+			failed = err
+			continue
+		}
+
+		if !handled {
+			logs.PrintLog(ctx, "[usecase] ReassignUnavailableReviews", fmt.Sprintf("Period %+v of %+v stays pending", period.PeriodId, period.User.SystemId))
+			continue
+		}
+
+		if err := u.repo.MarkUnavailabilityHandled(ctx, period.PeriodId); err != nil {
+			logs.PrintLog(ctx, "[usecase] ReassignUnavailableReviews", err.Error())
+			failed = appErrors.ErrServerError
+			continue
+		}
+
+		logs.PrintLog(ctx, "[usecase] ReassignUnavailableReviews", fmt.Sprintf("Handled period %+v of %+v", period.PeriodId, period.User.SystemId))
+	}
+
+	return failed
+}
+
+// reassignUnavailability replaces the user of the period on each open review
+// and tells whether every review found a replacement.
+func (u *UseCase) reassignUnavailability(ctx context.Context, period *models.Unavailability) (bool, error) {
+	changes, err := u.planReviewReplacements(ctx, []*models.User{period.User})
+	if err != nil {
+		return false, err
+	}
+
+	reason := "reviewer is unavailable"
+	if period.Reason != "" {
+		reason += ": " + period.Reason
+	}
+
+	handled := true
+	for _, change := range changes {
+		if change.NewReviewer == nil {
+			logs.PrintLog(ctx, "[usecase] reassignUnavailability", fmt.Sprintf("No replacement for %+v on %+v", period.User.SystemId, change.PullRequestSystemId))
+			handled = false
+			continue
+		}
+
+		events := changeEvents(ctx, []*models.ReviewerChange{change}, reason)
+		err := u.repo.ReplaceReviewers(ctx, change.PullRequestId, change.OldReviewer.UserId, change.NewReviewer.UserId, events)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] reassignUnavailability", err.Error())
+			return false, appErrors.ErrServerError
+		}
+
+		u.publishChanges([]*models.ReviewerChange{change})
+	}
+
+	return handled, nil
+}
+
+// RunUnavailabilityJob calls ReassignUnavailableReviews every interval until
+// ctx is done.
+func (u *UseCase) RunUnavailabilityJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = u.ReassignUnavailableReviews(ctx)
+		}
+	}
+}
+
+func (u *UseCase) BulkDeactivate(ctx context.Context, dto *models.BulkDeactivateDTO) (*models.BulkDeactivateResultDTO, error) {
+	if dto.TeamName == "" && len(dto.UserIds) == 0 {
+		logs.PrintLog(ctx, "[usecase] BulkDeactivate", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	seen := make(map[string]bool)
+	var users []*models.User
+
+	if dto.TeamName != "" {
+		team, err := u.findTeam(ctx, dto.TeamName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range team.TeamMembers {
+			seen[m.SystemId] = true
+			users = append(users, m)
+		}
+	}
+
+	for _, userId := range dto.UserIds {
+		if seen[userId] {
+			continue
+		}
+
+		user, err := u.repo.GetUserBySystemId(ctx, userId)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] BulkDeactivate", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		if user == nil {
+			logs.PrintLog(ctx, "[usecase] BulkDeactivate", fmt.Sprintf("User not found: %+v", userId))
+			return nil, appErrors.ErrResourceNotFound
+		}
+
+		seen[userId] = true
+		users = append(users, user)
+	}
+
+	changes, err := u.planReviewReplacements(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	userIds := make([]int, 0, len(users))
+	for _, user := range users {
+		userIds = append(userIds, user.UserId)
+	}
+
+	events := changeEvents(ctx, changes, "reviewer deactivated")
+
+	if err := u.repo.DeactivateUsers(ctx, userIds, changes, events); err != nil {
+		logs.PrintLog(ctx, "[usecase] BulkDeactivate", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	inactive := false
+	for _, user := range users {
+		u.events.Publish(models.LiveEventDTO{
+			Type:     models.LiveUserActivity,
+			TeamName: user.TeamName,
+			UserId:   user.SystemId,
+			IsActive: &inactive,
+		})
+	}
+	u.publishChanges(changes)
+
+	result := &models.BulkDeactivateResultDTO{
+		DeactivatedUsers: make([]string, 0, len(users)),
+		Reassigned:       make([]models.ReviewerChangeDTO, 0),
+		Dropped:          make([]models.ReviewerChangeDTO, 0),
+		WithoutReviewers: make([]string, 0),
+	}
+
+	for _, user := range users {
+		result.DeactivatedUsers = append(result.DeactivatedUsers, user.SystemId)
+	}
+
+	// the last change of a PR holds its final number of reviewers
+	reviewersLeft := make(map[string]int)
+	var order []string
+	for i, c := range reviewerChangesToDto(changes) {
+		if changes[i].NewReviewer != nil {
+			result.Reassigned = append(result.Reassigned, c)
+		} else {
+			result.Dropped = append(result.Dropped, c)
+		}
+
+		if _, ok := reviewersLeft[c.PullRequestId]; !ok {
+			order = append(order, c.PullRequestId)
+		}
+		reviewersLeft[c.PullRequestId] = changes[i].ReviewersLeft
+	}
+
+	for _, prId := range order {
+		if reviewersLeft[prId] == 0 {
+			result.WithoutReviewers = append(result.WithoutReviewers, prId)
+		}
+	}
+
+	logs.PrintLog(ctx, "[usecase] BulkDeactivate", fmt.Sprintf("Deactivated: %+v, reassigned: %+v, dropped: %+v", len(users), len(result.Reassigned), len(result.Dropped)))
+	return result, nil
+}
+
+func (u *UseCase) GetReview(ctx context.Context, userSystemId string) (*models.ReviewDTO, error) {
+	user, err := u.repo.GetUserBySystemId(ctx, userSystemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	reviews, err := u.repo.GetListReviewsByUserId(ctx, user.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	reviewDto := &models.ReviewDTO{
+		UserId:      user.SystemId,
+		PullRequest: make([]models.PullRequestShortDTO, 0, len(reviews)),
+	}
+
+	for _, pr := range reviews {
+		prDto := models.PullRequestShortDTO{
+			PullRequestId:   pr.SystemId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorSystemId,
+			Status:          pr.Status,
+			Decision:        models.DecisionPending,
+		}
+
+		if d, ok := pr.Decisions[user.UserId]; ok {
+			prDto.Decision = d.Decision
+			prDto.DecidedAt = d.DecidedAt.Format(time.RFC3339)
+		}
+
+		reviewDto.PullRequest = append(reviewDto.PullRequest, prDto)
+	}
+
+	logs.PrintLog(ctx, "[usecase] GetReview", fmt.Sprintf("Member found: %+v", user.SystemId))
+	return reviewDto, nil
+}
+
+func (u *UseCase) CreatePullRequest(ctx context.Context, dto *models.InputCreatePullRequestDTO) (*models.OutputCreatePullRequestDTO, error) {
+	exists, err := u.repo.PullRequestExists(ctx, dto.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if exists {
+		logs.PrintLog(ctx, "[usecase] CreatePullRequest", appErrors.ErrPullRequestExists.Error())
+		return nil, appErrors.ErrPullRequestExists
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.AuthorId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	team, err := u.getTeam(ctx, user.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.repo.GetTeamMembers(ctx, user.TeamId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	var candidates []*models.User
+
+	for _, member := range members {
+		if member.SystemId == dto.AuthorId {
+			continue
+		}
+		if available(member) {
+			candidates = append(candidates, member)
+		}
+	}
+
+	count := team.MaxReviewers
+	if dto.ReviewersCount != nil {
+		count = *dto.ReviewersCount
+		if count < team.MinReviewers || count > team.MaxReviewers {
+			logs.PrintLog(ctx, "[usecase] CreatePullRequest", appErrors.ErrInvalidReviewersCount.Error())
+			return nil, appErrors.ErrInvalidReviewersCount
+		}
+	}
+
+	changedFiles := make([]string, 0, len(dto.ChangedFiles))
+	for _, file := range dto.ChangedFiles {
+		if file = strings.TrimSpace(file); file != "" {
+			changedFiles = append(changedFiles, file)
+		}
+	}
+
+	requiredTags, err := normalizeTags(dto.RequiredTags)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
+		return nil, err
+	}
+
+	pr := &models.PullRequest{
+		SystemId:        dto.PullRequestId,
+		PullRequestName: dto.PullRequestName,
+		AuthorId:        user.UserId,
+		AuthorSystemId:  user.SystemId,
+		AuthorTeamId:    team.TeamId,
+		Status:          models.StatusOpen,
+		ChangedFiles:    changedFiles,
+		RequiredTags:    requiredTags,
+	}
+
+	trace := newAssignmentTrace(pr, u.strategyOf(team))
+	trace.consider(members)
+
+	reviewers := make([]*models.User, 0)
+	pools := make([]models.ReviewerPoolDTO, 0)
+	capped := false
+	var seed *int64
+
+	// reviewers of a draft are picked when it is marked ready
+	if dto.Draft {
+		pr.Status = models.StatusDraft
+	} else {
+		s, rng := u.newRand()
+		seed = &s
+		reviewers, pools, capped, err = u.pickReviewers(ctx, rng, trace, team, pr, candidates, count)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+	}
+
+	logs.PrintLog(ctx, "[usecase] CreatePullRequest", fmt.Sprintf("Reviewers: %+v", reviewers))
+
+	events := make([]*models.PrEvent, 0, len(reviewers)+1)
+	events = append(events, &models.PrEvent{
+		EventType: models.EventPullRequestCreated,
+		Actor:     actor.FromContext(ctx),
+		Reason:    "pull request created",
+	})
+
+	for i, reviewer := range reviewers {
+		events = append(events, &models.PrEvent{
+			EventType:     models.EventReviewerAssigned,
+			Actor:         actor.FromContext(ctx),
+			NewReviewerId: reviewer.UserId,
+			Reason:        assignEventReason(team, pools[i]),
+			Seed:          seed,
+		})
+	}
+
+	err = u.repo.CreatePullRequestAndReview(ctx, pr, reviewers, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if !dto.Draft {
+		u.explain(ctx, trace, models.AssignmentCreated, seed, nil)
+	}
+
+	u.notifyUsers(ctx, userIds(reviewers), notify.Event{
+		Kind:            notify.KindAssigned,
+		PullRequestId:   pr.SystemId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorSystemId,
+	})
+
+	if len(reviewers) > 0 {
+		u.events.Publish(models.LiveEventDTO{
+			Type:          models.LiveReviewerAssigned,
+			TeamName:      team.TeamName,
+			PullRequestId: pr.SystemId,
+			AuthorId:      pr.AuthorSystemId,
+			Reviewers:     userSystemIds(reviewers),
+		})
+	}
+
+	prDto := &models.OutputCreatePullRequestDTO{
+		PullRequestID:      pr.SystemId,
+		PullRequestName:    pr.PullRequestName,
+		AuthorID:           pr.AuthorSystemId,
+		Status:             pr.Status,
+		AssignedReviewers:  make([]string, 0, len(reviewers)),
+		ReviewerPools:      pools,
+		RequiredReviewers:  team.MinReviewers,
+		NotEnoughReviewers: len(reviewers) < team.MinReviewers,
+		CapacityLimited:    capped,
+	}
+
+	for _, reviewer := range reviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, reviewer.SystemId)
+	}
+
+	return prDto, nil
+}
+
+// reviewDecisions lists the decision of every assigned reviewer and counts
+// approvals among them.
+func reviewDecisions(pr *models.PullRequest) ([]models.ReviewerDecisionDTO, int) {
+	reviews := make([]models.ReviewerDecisionDTO, 0, len(pr.AssigneeReviewers))
+	approvals := 0
+
+	for _, r := range pr.AssigneeReviewers {
+		review := models.ReviewerDecisionDTO{
+			ReviewerId: r.SystemId,
+			Decision:   models.DecisionPending,
+		}
+
+		if d, ok := pr.Decisions[r.UserId]; ok {
+			review.Decision = d.Decision
+			review.DecidedAt = d.DecidedAt.Format(time.RFC3339)
+			if d.Decision == models.DecisionApproved {
+				approvals++
+			}
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	return reviews, approvals
+}
+
+// checkMergePolicy returns every rule of the team merge policy the PR breaks
+// when merged by mergedBy.
+func checkMergePolicy(pr *models.PullRequest, team *models.Team, approvals int, mergedBy string) []appErrors.PolicyViolation {
+	violations := make([]appErrors.PolicyViolation, 0)
+
+	if team.MergePolicy.RequireReviewer && len(pr.AssigneeReviewers) == 0 {
+		violations = append(violations, appErrors.PolicyViolation{
+			Rule:    models.RuleRequireReviewer,
+			Message: "PR has no reviewers assigned",
+		})
+	}
+
+	if approvals < team.RequiredApprovals {
+		violations = append(violations, appErrors.PolicyViolation{
+			Rule:    models.RuleRequiredApprovals,
+			Message: fmt.Sprintf("PR has %d of %d required approvals", approvals, team.RequiredApprovals),
+		})
+	}
+
+	var requested, approvers []string
+	for _, r := range pr.AssigneeReviewers {
+		d, ok := pr.Decisions[r.UserId]
+		if !ok {
+			continue
+		}
+		switch d.Decision {
+		case models.DecisionChangesRequested:
+			requested = append(requested, r.SystemId)
+		case models.DecisionApproved:
+			approvers = append(approvers, r.SystemId)
+		}
+	}
+
+	if team.MergePolicy.NoChangesRequested && len(requested) > 0 {
+		violations = append(violations, appErrors.PolicyViolation{
+			Rule:    models.RuleNoChangesRequested,
+			Message: fmt.Sprintf("changes requested by %s", strings.Join(requested, ", ")),
+		})
+	}
+
+	if team.MergePolicy.NoSelfApproval && len(approvers) == 1 && approvers[0] == mergedBy {
+		violations = append(violations, appErrors.PolicyViolation{
+			Rule:    models.RuleNoSelfApproval,
+			Message: fmt.Sprintf("%s is the only approver and cannot merge", mergedBy),
+		})
+	}
+
+	return violations
+}
+
+func (u *UseCase) MergePullRequest(ctx context.Context, dto *models.InputMergePullRequestDTO) (*models.OutputMergePullRequestDTO, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, dto.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	team, err := u.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status == models.StatusMerged {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", fmt.Sprintf("Pull request is already merged: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		prDto := &models.OutputMergePullRequestDTO{
+			PullRequestID:      pr.SystemId,
+			PullRequestName:    pr.PullRequestName,
+			AuthorID:           pr.AuthorSystemId,
+			Status:             pr.Status,
+			AssignedReviewers:  make([]string, 0, len(pr.AssigneeReviewers)),
+			RequiredReviewers:  team.MinReviewers,
+			NotEnoughReviewers: len(pr.AssigneeReviewers) < team.MinReviewers,
+			RequiredApprovals:  team.RequiredApprovals,
+		}
+
+		prDto.Reviews, prDto.Approvals = reviewDecisions(pr)
+		prDto.MergedAt = pr.MergedAt.Time.Format(time.RFC3339)
+
+		for _, r := range pr.AssigneeReviewers {
+			prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+		}
+
+		return prDto, nil
+	}
+
+	status, err := checkTransition(pr.Status, models.EventPullRequestMerged)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		return nil, err
+	}
+
+	reviews, approvals := reviewDecisions(pr)
+	violations := checkMergePolicy(pr, team, approvals, actor.FromContext(ctx))
+
+	reason := "pull request merged"
+	bypassed := make([]string, 0, len(violations))
+
+	if dto.Force {
+		if !actor.IsAdmin(ctx) {
+			logs.PrintLog(ctx, "[usecase] MergePullRequest", fmt.Sprintf("Force merge denied for actor %+v", actor.FromContext(ctx)))
+			return nil, appErrors.ErrForbidden
+		}
+
+		for _, v := range violations {
+			bypassed = append(bypassed, v.Rule)
+		}
+
+		reason = "pull request force merged"
+		if len(bypassed) > 0 {
+			reason = fmt.Sprintf("pull request force merged, bypassed: %s", strings.Join(bypassed, ", "))
+		}
+	} else if len(violations) > 0 {
+		err := &appErrors.MergePolicyError{Violations: violations}
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		return nil, err
+	}
+
+	pr.Status = status
+	logs.PrintLog(ctx, "[usecase] MergePullRequest", fmt.Sprintf("Pull request is merged first time: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+
+	events := []*models.PrEvent{{
+		PullRequestId: pr.PullRequestId,
+		EventType:     models.EventPullRequestMerged,
+		Actor:         actor.FromContext(ctx),
+		Reason:        reason,
+	}}
+
+	mergedTime, err := u.repo.SetMergedStatusPullRequest(ctx, pr.PullRequestId, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	u.notifyUsers(ctx, append(userIds(pr.AssigneeReviewers), pr.AuthorId), notify.Event{
+		Kind:            notify.KindMerged,
+		PullRequestId:   pr.SystemId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorSystemId,
+	})
+
+	u.events.Publish(models.LiveEventDTO{
+		Type:          models.LivePullRequestMerged,
+		TeamName:      team.TeamName,
+		PullRequestId: pr.SystemId,
+		AuthorId:      pr.AuthorSystemId,
+		Reviewers:     userSystemIds(pr.AssigneeReviewers),
+	})
+
+	prDto := &models.OutputMergePullRequestDTO{
+		PullRequestID:      pr.SystemId,
+		PullRequestName:    pr.PullRequestName,
+		AuthorID:           pr.AuthorSystemId,
+		Status:             pr.Status,
+		AssignedReviewers:  make([]string, 0, len(pr.AssigneeReviewers)),
+		RequiredReviewers:  team.MinReviewers,
+		NotEnoughReviewers: len(pr.AssigneeReviewers) < team.MinReviewers,
+		Reviews:            reviews,
+		Approvals:          approvals,
+		RequiredApprovals:  team.RequiredApprovals,
+		Forced:             dto.Force,
+	}
+
+	if len(bypassed) > 0 {
+		prDto.BypassedRules = bypassed
+	}
+
+	prDto.MergedAt = mergedTime.Time.Format(time.RFC3339)
+
+	for _, r := range pr.AssigneeReviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+	}
+
+	return prDto, nil
+}
+
+// changePullRequestStatus applies a lifecycle event to the PR. A PR that becomes
+// OPEN without reviewers gets them picked the same way as on creation.
+func (u *UseCase) changePullRequestStatus(ctx context.Context, prSystemId string, eventType string, reason string) (*models.OutputChangeStatusPullRequestDTO, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, prSystemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] changePullRequestStatus", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] changePullRequestStatus", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	status, err := checkTransition(pr.Status, eventType)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] changePullRequestStatus", err.Error())
+		return nil, err
+	}
+
+	team, err := u.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*models.PrEvent{{
+		PullRequestId: pr.PullRequestId,
+		EventType:     eventType,
+		Actor:         actor.FromContext(ctx),
+		Reason:        reason,
+	}}
+
+	reviewers := make([]*models.User, 0)
+	var pools []models.ReviewerPoolDTO
+	var trace *assignmentTrace
+	var seed *int64
+	capped := false
+	if status == models.StatusOpen && len(pr.AssigneeReviewers) == 0 {
+		members, err := u.repo.GetTeamMembers(ctx, pr.AuthorTeamId)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] changePullRequestStatus", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		trace = newAssignmentTrace(pr, u.strategyOf(team))
+		trace.consider(members)
+
+		s, rng := u.newRand()
+		seed = &s
+		reviewers, pools, capped, err = u.pickReviewers(ctx, rng, trace, team, pr, replacementCandidates(members, pr, nil), team.MaxReviewers)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] changePullRequestStatus", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		for i, reviewer := range reviewers {
+			events = append(events, &models.PrEvent{
+				PullRequestId: pr.PullRequestId,
+				EventType:     models.EventReviewerAssigned,
+				Actor:         actor.FromContext(ctx),
+				NewReviewerId: reviewer.UserId,
+				Reason:        assignEventReason(team, pools[i]),
+				Seed:          seed,
+			})
+		}
+	}
+
+	err = u.repo.SetPullRequestStatus(ctx, pr.PullRequestId, status, reviewers, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] changePullRequestStatus", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if trace != nil {
+		u.explain(ctx, trace, models.AssignmentReady, seed, nil)
+	}
+
+	// reviewers of a draft are notified once they are picked here
+	u.notifyUsers(ctx, userIds(reviewers), notify.Event{
+		Kind:            notify.KindAssigned,
+		PullRequestId:   pr.SystemId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorSystemId,
+	})
+
+	if len(reviewers) > 0 {
+		u.events.Publish(models.LiveEventDTO{
+			Type:          models.LiveReviewerAssigned,
+			TeamName:      team.TeamName,
+			PullRequestId: pr.SystemId,
+			AuthorId:      pr.AuthorSystemId,
+			Reviewers:     userSystemIds(reviewers),
+		})
+	}
+
+	prDto := &models.OutputChangeStatusPullRequestDTO{
+		PullRequestID:     pr.SystemId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorSystemId,
+		Status:            status,
+		AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)+len(reviewers)),
+		ReviewerPools:     pools,
+		RequiredReviewers: team.MinReviewers,
+		CapacityLimited:   capped,
+	}
+
+	for _, r := range pr.AssigneeReviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+	}
+	for _, r := range reviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+	}
+	prDto.NotEnoughReviewers = len(prDto.AssignedReviewers) < team.MinReviewers
+
+	logs.PrintLog(ctx, "[usecase] changePullRequestStatus", fmt.Sprintf("Pull request %+v moved from %+v to %+v", pr.SystemId, pr.Status, status))
+	return prDto, nil
+}
+
+func (u *UseCase) MarkReadyPullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error) {
+	if dto.PullRequestId == "" {
+		logs.PrintLog(ctx, "[usecase] MarkReadyPullRequest", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	return u.changePullRequestStatus(ctx, dto.PullRequestId, models.EventPullRequestReady, "marked ready for review")
+}
+
+func (u *UseCase) ClosePullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error) {
+	if dto.PullRequestId == "" {
+		logs.PrintLog(ctx, "[usecase] ClosePullRequest", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	return u.changePullRequestStatus(ctx, dto.PullRequestId, models.EventPullRequestClosed, "pull request closed")
+}
+
+func (u *UseCase) ReopenPullRequest(ctx context.Context, dto *models.InputChangeStatusPullRequestDTO) (*models.OutputChangeStatusPullRequestDTO, error) {
+	if dto.PullRequestId == "" {
+		logs.PrintLog(ctx, "[usecase] ReopenPullRequest", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	return u.changePullRequestStatus(ctx, dto.PullRequestId, models.EventPullRequestReopened, "pull request reopened")
+}
+
+// reassignPoolTeams returns the teams whose members may replace user, following
+// the reassign pool of the author's team; the first team's strategy picks.
+func (u *UseCase) reassignPoolTeams(ctx context.Context, pool *replacementPool, user *models.User, authorTeam *models.Team) ([]*models.Team, error) {
+	if authorTeam.ReassignPool == models.ReassignPoolAuthorTeam || authorTeam.ReassignPool == "" || user.TeamId == authorTeam.TeamId {
+		return []*models.Team{authorTeam}, nil
+	}
+
+	// a reviewer without a team has no teammates to fall back on
+	if user.TeamId == 0 {
+		if authorTeam.ReassignPool == models.ReassignPoolUnion {
+			return []*models.Team{authorTeam}, nil
+		}
+		return nil, nil
+	}
+
+	reviewerTeam, err := u.poolTeam(ctx, pool, user.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorTeam.ReassignPool == models.ReassignPoolUnion {
+		return []*models.Team{authorTeam, reviewerTeam}, nil
+	}
+
+	return []*models.Team{reviewerTeam}, nil
+}
+
+// pickReplacement selects a reviewer for the slot of user from the reassign
+// pool of the author's team. It returns nil when nobody can take the slot,
+// with capped set when that is because of capacity limits. Members of the pool
+// are noted in trace.
+func (u *UseCase) pickReplacement(ctx context.Context, rng *rand.Rand, trace *assignmentTrace, pool *replacementPool, pr *models.PullRequest, user *models.User, authorTeam *models.Team) (*models.User, bool, error) {
+	teams, err := u.reassignPoolTeams(ctx, pool, user, authorTeam)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(teams) == 0 {
+		return nil, false, nil
+	}
+
+	var members []*models.User
+	for _, team := range teams {
+		teamMembers, err := u.poolMembers(ctx, pool, team.TeamId)
+		if err != nil {
+			return nil, false, err
+		}
+
+		members = append(members, teamMembers...)
+	}
+
+	// the first team of the pool selects, which need not be the author's
+	team := teams[0]
+	if trace != nil {
+		trace.strategy = u.strategyOf(team)
+	}
+	trace.consider(members)
+
+	candidates, capped := withinCapacity(replacementCandidates(members, pr, pool.excluded))
+	if len(candidates) == 0 {
+		return nil, capped, nil
+	}
+
+	picked, err := u.selectReviewers(ctx, rng, team, pr, candidates, 1)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] pickReplacement", err.Error())
+		return nil, false, appErrors.ErrServerError
+	}
+
+	trace.selected(picked[0], "")
+	return picked[0], false, nil
+}
+
+// namedReviewer loads a user explicitly chosen as a reviewer of pr and checks
+// that the user may take the review.
+func (u *UseCase) namedReviewer(ctx context.Context, pr *models.PullRequest, systemId string) (*models.User, error) {
+	user, err := u.repo.GetUserBySystemId(ctx, systemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] namedReviewer", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] namedReviewer", fmt.Sprintf("User not found: %+v", systemId))
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	if user.SystemId == pr.AuthorSystemId {
+		logs.PrintLog(ctx, "[usecase] namedReviewer", appErrors.ErrReviewerIsAuthor.Error())
+		return nil, appErrors.ErrReviewerIsAuthor
+	}
+
+	for _, r := range pr.AssigneeReviewers {
+		if r.SystemId == user.SystemId {
+			logs.PrintLog(ctx, "[usecase] namedReviewer", fmt.Sprintf("User %+v is already assigned to %+v", systemId, pr.SystemId))
+			return nil, appErrors.ErrReviewerAlreadyAssigned
+		}
+	}
+
+	return user, nil
+}
+
+// checkReviewersEditable rejects manual reviewer changes on PRs that are not
+// under review.
+func checkReviewersEditable(pr *models.PullRequest) error {
+	switch pr.Status {
+	case models.StatusMerged:
+		return appErrors.ErrPullRequestMerged
+	case models.StatusClosed:
+		return appErrors.ErrPullRequestClosed
+	case models.StatusDraft:
+		return appErrors.ErrPullRequestDraft
+	default:
+		return nil
+	}
+}
+
+func (u *UseCase) AddReviewers(ctx context.Context, dto *models.InputChangeReviewersDTO) (*models.OutputChangeReviewersDTO, error) {
+	if dto.PullRequestId == "" || len(dto.ReviewerIds) == 0 {
+		logs.PrintLog(ctx, "[usecase] AddReviewers", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	pr, team, err := u.reviewersEditablePullRequest(ctx, dto.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	added := make([]*models.User, 0, len(dto.ReviewerIds))
+	events := make([]*models.PrEvent, 0, len(dto.ReviewerIds))
+
+	for _, id := range dto.ReviewerIds {
+		user, err := u.namedReviewer(ctx, pr, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// the next ids must not repeat this one either
+		pr.AssigneeReviewers = append(pr.AssigneeReviewers, user)
+		added = append(added, user)
+		events = append(events, &models.PrEvent{
+			PullRequestId: pr.PullRequestId,
+			EventType:     models.EventReviewerAssigned,
+			Actor:         actor.FromContext(ctx),
+			NewReviewerId: user.UserId,
+			Reason:        "added manually",
+		})
+	}
+
+	err = u.repo.UpdateReviewers(ctx, pr.PullRequestId, added, nil, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddReviewers", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	u.events.Publish(models.LiveEventDTO{
+		Type:          models.LiveReviewerAssigned,
+		TeamName:      team.TeamName,
+		PullRequestId: pr.SystemId,
+		AuthorId:      pr.AuthorSystemId,
+		Reviewers:     userSystemIds(added),
+	})
+
+	logs.PrintLog(ctx, "[usecase] AddReviewers", fmt.Sprintf("Reviewers added to %+v: %+v", pr.SystemId, dto.ReviewerIds))
+	return changeReviewersDto(pr, team), nil
+}
+
+func (u *UseCase) RemoveReviewers(ctx context.Context, dto *models.InputChangeReviewersDTO) (*models.OutputChangeReviewersDTO, error) {
+	if dto.PullRequestId == "" || len(dto.ReviewerIds) == 0 {
+		logs.PrintLog(ctx, "[usecase] RemoveReviewers", appErrors.ErrParseData.Error())
+		return nil, appErrors.ErrParseData
+	}
+
+	pr, team, err := u.reviewersEditablePullRequest(ctx, dto.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := make(map[string]*models.User, len(pr.AssigneeReviewers))
+	for _, r := range pr.AssigneeReviewers {
+		assigned[r.SystemId] = r
+	}
+
+	removed := make([]*models.User, 0, len(dto.ReviewerIds))
+	events := make([]*models.PrEvent, 0, len(dto.ReviewerIds))
+
+	for _, id := range dto.ReviewerIds {
+		user, ok := assigned[id]
+		if !ok {
+			logs.PrintLog(ctx, "[usecase] RemoveReviewers", fmt.Sprintf("User %+v is not assigned to %+v", id, pr.SystemId))
+			return nil, appErrors.ErrReviewerNotAssigned
+		}
+
+		delete(assigned, id)
+		removed = append(removed, user)
+		events = append(events, &models.PrEvent{
+			PullRequestId: pr.PullRequestId,
+			EventType:     models.EventReviewerRemoved,
+			Actor:         actor.FromContext(ctx),
+			OldReviewerId: user.UserId,
+			Reason:        "removed manually",
+		})
+	}
+
+	err = u.repo.UpdateReviewers(ctx, pr.PullRequestId, nil, removed, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] RemoveReviewers", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	for _, r := range removed {
+		u.events.Publish(models.LiveEventDTO{
+			Type:          models.LiveReviewerRemoved,
+			TeamName:      team.TeamName,
+			PullRequestId: pr.SystemId,
+			AuthorId:      pr.AuthorSystemId,
+			OldReviewerId: r.SystemId,
+		})
+	}
+
+	left := make([]*models.User, 0, len(assigned))
+	for _, r := range pr.AssigneeReviewers {
+		if _, ok := assigned[r.SystemId]; ok {
+			left = append(left, r)
+		}
+	}
+	pr.AssigneeReviewers = left
+
+	logs.PrintLog(ctx, "[usecase] RemoveReviewers", fmt.Sprintf("Reviewers removed from %+v: %+v", pr.SystemId, dto.ReviewerIds))
+	return changeReviewersDto(pr, team), nil
+}
+
+func (u *UseCase) reviewersEditablePullRequest(ctx context.Context, prSystemId string) (*models.PullRequest, *models.Team, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, prSystemId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] reviewersEditablePullRequest", err.Error())
+		return nil, nil, appErrors.ErrServerError
+	}
+
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] reviewersEditablePullRequest", appErrors.ErrResourceNotFound.Error())
+		return nil, nil, appErrors.ErrResourceNotFound
+	}
+
+	if err := checkReviewersEditable(pr); err != nil {
+		logs.PrintLog(ctx, "[usecase] reviewersEditablePullRequest", fmt.Sprintf("Pull request %+v is %+v: %+v", pr.SystemId, pr.Status, err.Error()))
+		return nil, nil, err
+	}
+
+	team, err := u.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pr, team, nil
+}
+
+func changeReviewersDto(pr *models.PullRequest, team *models.Team) *models.OutputChangeReviewersDTO {
+	prDto := &models.OutputChangeReviewersDTO{
+		PullRequestID:     pr.SystemId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorSystemId,
+		Status:            pr.Status,
+		AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
+		RequiredReviewers: team.MinReviewers,
+	}
+
+	for _, r := range pr.AssigneeReviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+	}
+	prDto.NotEnoughReviewers = len(prDto.AssignedReviewers) < team.MinReviewers
+
+	return prDto
+}
+
+func (u *UseCase) Reassign(ctx context.Context, dto *models.InputReassignDTO) (*models.OutputReassignDTO, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, dto.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] MergePullRequest", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if user == nil {
+		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	if pr.Status == models.StatusMerged {
+		logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("Pull request is already merged: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		return nil, appErrors.ErrPullRequestMerged
+	}
+
+	if pr.Status == models.StatusClosed {
+		logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("Pull request is closed: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		return nil, appErrors.ErrPullRequestClosed
+	}
+
+	authorTeam, err := u.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	IsUserReviewThisPR := false
+	otherReviewers := make([]*models.User, 0, len(pr.AssigneeReviewers))
+	for _, r := range pr.AssigneeReviewers {
+		if r.SystemId == dto.UserId {
+			IsUserReviewThisPR = true
+			continue
+		}
+		otherReviewers = append(otherReviewers, r)
+	}
+
+	if !IsUserReviewThisPR {
+		// return pr without replace
+		prDto := &models.OutputReassignDTO{
+			PullRequestID:     pr.SystemId,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorSystemId,
+			Status:            pr.Status,
+			AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
+			ReplacedBy:        "-",
+			RequiredReviewers: authorTeam.MinReviewers,
+		}
+
+		for _, r := range pr.AssigneeReviewers {
+			prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+		}
+		prDto.NotEnoughReviewers = len(prDto.AssignedReviewers) < authorTeam.MinReviewers
+
+		logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("User is not assigned to pull request: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		return prDto, nil
+	}
+
+	var newReviewer *models.User
+	var seed *int64
+	capped := false
+	reason := "reassign requested"
+	trace := newAssignmentTrace(pr, u.strategyOf(authorTeam))
+
+	if dto.RequestedReviewerId != "" {
+		newReviewer, err = u.namedReviewer(ctx, pr, dto.RequestedReviewerId)
+		if err != nil {
+			return nil, err
+		}
+		reason = "reassign requested to a named reviewer"
+		trace.strategy = models.StrategyRequested
+		trace.selected(newReviewer, "")
+	} else {
+		s, rng := u.newRand()
+		seed = &s
+		newReviewer, capped, err = u.pickReplacement(ctx, rng, trace, newReplacementPool(nil), pr, user, authorTeam)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newReviewer == nil {
+		events := []*models.PrEvent{{
+			PullRequestId: pr.PullRequestId,
+			EventType:     models.EventReviewerRemoved,
+			Actor:         actor.FromContext(ctx),
+			OldReviewerId: user.UserId,
+			Reason:        "reassign requested, no active candidates",
+		}}
+
+		err = u.repo.DeleteReview(ctx, pr.PullRequestId, user.UserId, events)
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] Reassign", err.Error())
+			return nil, appErrors.ErrServerError
+		}
+
+		u.explain(ctx, trace, models.AssignmentReassigned, seed, user)
+
+		u.notifyUsers(ctx, []int{user.UserId}, notify.Event{
+			Kind:            notify.KindUnassigned,
+			PullRequestId:   pr.SystemId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorSystemId,
+		})
+
+		u.events.Publish(models.LiveEventDTO{
+			Type:          models.LiveReviewerRemoved,
+			TeamName:      authorTeam.TeamName,
+			PullRequestId: pr.SystemId,
+			AuthorId:      pr.AuthorSystemId,
+			OldReviewerId: user.SystemId,
+		})
+
+		prDto := &models.OutputReassignDTO{
+			PullRequestID:     pr.SystemId,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorSystemId,
+			Status:            pr.Status,
+			AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
+			ReplacedBy:        "-",
+			RequiredReviewers: authorTeam.MinReviewers,
+			CapacityLimited:   capped,
+		}
+
+		for _, r := range otherReviewers {
+			prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+		}
+		prDto.NotEnoughReviewers = len(prDto.AssignedReviewers) < authorTeam.MinReviewers
+
+		return prDto, nil
+	}
+
+	events := []*models.PrEvent{{
+		PullRequestId: pr.PullRequestId,
+		EventType:     models.EventReviewerReplaced,
+		Actor:         actor.FromContext(ctx),
+		OldReviewerId: user.UserId,
+		NewReviewerId: newReviewer.UserId,
+		Reason:        reason,
+		Seed:          seed,
+	}}
+
+	err = u.repo.ReplaceReviewers(ctx, pr.PullRequestId, user.UserId, newReviewer.UserId, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] Reassign", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	u.explain(ctx, trace, models.AssignmentReassigned, seed, user)
+
+	u.notifyUsers(ctx, []int{newReviewer.UserId}, notify.Event{
+		Kind:            notify.KindAssigned,
+		PullRequestId:   pr.SystemId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorSystemId,
+	})
+	u.notifyUsers(ctx, []int{user.UserId}, notify.Event{
+		Kind:            notify.KindUnassigned,
+		PullRequestId:   pr.SystemId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorSystemId,
+		ReplacedBy:      newReviewer.SystemId,
+	})
+
+	u.events.Publish(models.LiveEventDTO{
+		Type:          models.LiveReviewerReplaced,
+		TeamName:      authorTeam.TeamName,
+		PullRequestId: pr.SystemId,
+		AuthorId:      pr.AuthorSystemId,
+		OldReviewerId: user.SystemId,
+		NewReviewerId: newReviewer.SystemId,
+	})
+
+	prDto := &models.OutputReassignDTO{
+		PullRequestID:     pr.SystemId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorSystemId,
+		Status:            pr.Status,
+		AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
+		ReplacedBy:        newReviewer.SystemId,
+		RequiredReviewers: authorTeam.MinReviewers,
+	}
+
+	prDto.AssignedReviewers = append(prDto.AssignedReviewers, newReviewer.SystemId)
+
+	for _, r := range otherReviewers {
+		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+	}
+	prDto.NotEnoughReviewers = len(prDto.AssignedReviewers) < authorTeam.MinReviewers
+
+	logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("Reassigned pull request: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+	return prDto, nil
+}
+
+func validateDecision(decision string) error {
+	switch decision {
+	case models.DecisionApproved, models.DecisionChangesRequested, models.DecisionCommented:
+		return nil
+	default:
+		return appErrors.ErrUnknownDecision
+	}
+}
+
+func (u *UseCase) SubmitReview(ctx context.Context, dto *models.InputSubmitReviewDTO) (*models.OutputSubmitReviewDTO, error) {
+	if err := validateDecision(dto.Decision); err != nil {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", err.Error())
+		return nil, err
+	}
+
+	pr, err := u.repo.GetPullRequestById(ctx, dto.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
+
+	if pr.Status == models.StatusMerged {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", fmt.Sprintf("Pull request is already merged: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		return nil, appErrors.ErrPullRequestMerged
+	}
+
+	if pr.Status == models.StatusClosed {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", fmt.Sprintf("Pull request is closed: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+		return nil, appErrors.ErrPullRequestClosed
+	}
+
+	var reviewer *models.User
+	for _, r := range pr.AssigneeReviewers {
+		if r.SystemId == dto.ReviewerId {
+			reviewer = r
+			break
+		}
+	}
+
+	if reviewer == nil {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", appErrors.ErrReviewerNotAssigned.Error())
+		return nil, appErrors.ErrReviewerNotAssigned
+	}
+
+	// the reviewer is stored as the new one, the decision goes to the reason
+	events := []*models.PrEvent{{
+		PullRequestId: pr.PullRequestId,
+		EventType:     models.EventReviewSubmitted,
+		Actor:         actor.FromContext(ctx),
+		NewReviewerId: reviewer.UserId,
+		Reason:        dto.Decision,
+	}}
+
+	decidedAt, err := u.repo.SetReviewDecision(ctx, pr.PullRequestId, reviewer.UserId, dto.Decision, events)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", err.Error())
+		return nil, appErrors.ErrServerError
+	}
+
+	// the reviewer was replaced between reading the PR and the update
+	if !decidedAt.Valid {
+		logs.PrintLog(ctx, "[usecase] SubmitReview", appErrors.ErrReviewerNotAssigned.Error())
+		return nil, appErrors.ErrReviewerNotAssigned
 	}
 
-	logs.PrintLog(ctx, "[usecase] AddTeam", fmt.Sprintf("Team added: %+v", team.TeamName))
-	return nil
+	logs.PrintLog(ctx, "[usecase] SubmitReview", fmt.Sprintf("Reviewer %+v submitted %+v on %+v", reviewer.SystemId, dto.Decision, pr.SystemId))
+	return &models.OutputSubmitReviewDTO{
+		PullRequestId: pr.SystemId,
+		ReviewerId:    reviewer.SystemId,
+		Decision:      dto.Decision,
+		DecidedAt:     decidedAt.Time.Format(time.RFC3339),
+	}, nil
 }
 
-func (u *UseCase) GetTeamByName(ctx context.Context, teamName string) (*models.TeamDTO, error) {
-	team, err := u.repo.GetTeamByName(ctx, teamName)
+func (u *UseCase) GetPullRequestHistory(ctx context.Context, prSystemId string) (*models.PullRequestHistoryDTO, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, prSystemId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetTeamByName", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetPullRequestHistory", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	if team == nil {
-		logs.PrintLog(ctx, "[usecase] GetTeamByName", appErrors.ErrResourceNotFound.Error())
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] GetPullRequestHistory", appErrors.ErrResourceNotFound.Error())
 		return nil, appErrors.ErrResourceNotFound
 	}
 
-	logs.PrintLog(ctx, "[usecase] GetTeamByName", fmt.Sprintf("Team found: %+v", team.TeamName))
-
-	teamDto := &models.TeamDTO{
-		TeamName: team.TeamName,
-		Members:  make([]models.MemberDTO, 0, len(team.TeamMembers)),
+	events, err := u.repo.GetPullRequestEvents(ctx, pr.PullRequestId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetPullRequestHistory", err.Error())
+		return nil, appErrors.ErrServerError
 	}
 
-	for _, m := range team.TeamMembers {
-		memberDTO := models.MemberDTO{
-			UserID:   m.SystemId,
-			Username: m.UserName,
-			IsActive: m.IsActive,
-		}
+	historyDto := &models.PullRequestHistoryDTO{
+		PullRequestId: pr.SystemId,
+		Events:        make([]models.PullRequestEventDTO, 0, len(events)),
+	}
 
-		teamDto.Members = append(teamDto.Members, memberDTO)
+	for _, e := range events {
+		historyDto.Events = append(historyDto.Events, models.PullRequestEventDTO{
+			EventType:     e.EventType,
+			Actor:         e.Actor,
+			OldReviewerId: e.OldReviewerSystemId,
+			NewReviewerId: e.NewReviewerSystemId,
+			Reason:        e.Reason,
+			Seed:          e.Seed,
+			CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+		})
 	}
-	return teamDto, nil
+
+	logs.PrintLog(ctx, "[usecase] GetPullRequestHistory", fmt.Sprintf("History found: %+v events: %+v", pr.SystemId, len(events)))
+	return historyDto, nil
 }
 
-func (u *UseCase) SetIsActive(ctx context.Context, dto *models.SetIsActiveDTO) (*models.UserDTO, error) {
-	user, err := u.repo.SetIsActive(ctx, dto.UserID, dto.IsActive)
-	if err != nil {
-		logs.PrintLog(ctx, "[usecase] SetIsActive", err.Error())
-		return nil, appErrors.ErrServerError
+func explanationToDto(e *models.AssignmentExplanation) models.AssignmentExplanationDTO {
+	out := models.AssignmentExplanationDTO{
+		Trigger:            e.Trigger,
+		Actor:              e.Actor,
+		Strategy:           e.Strategy,
+		Seed:               e.Seed,
+		ReplacedReviewerId: e.ReplacedReviewerSystemId,
+		SelectedReviewers:  make([]string, 0),
+		Candidates:         make([]models.AssignmentCandidateDTO, 0, len(e.Candidates)),
+		Excluded:           make([]models.ExcludedCandidateDTO, 0),
+		CreatedAt:          e.CreatedAt.Format(time.RFC3339),
 	}
 
-	if user == nil {
-		logs.PrintLog(ctx, "[usecase] SetIsActive", appErrors.ErrResourceNotFound.Error())
-		return nil, appErrors.ErrResourceNotFound
-	}
+	for _, c := range e.Candidates {
+		if c.ExcludedReason != "" && !c.Selected {
+			out.Excluded = append(out.Excluded, models.ExcludedCandidateDTO{UserId: c.SystemId, Reason: c.ExcludedReason})
+			continue
+		}
 
-	userDto := &models.UserDTO{
-		UserId:   user.SystemId,
-		UserName: user.UserName,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		out.Candidates = append(out.Candidates, models.AssignmentCandidateDTO{UserId: c.SystemId, Selected: c.Selected, Pool: c.Pool})
+		if c.Selected {
+			out.SelectedReviewers = append(out.SelectedReviewers, c.SystemId)
+		}
 	}
 
-	logs.PrintLog(ctx, "[usecase] SetIsActive", fmt.Sprintf("Member updated: %+v set isActive to: %+v", dto.UserID, dto.IsActive))
-	return userDto, nil
+	return out
 }
 
-func (u *UseCase) GetReview(ctx context.Context, userSystemId string) (*models.ReviewDTO, error) {
-	user, err := u.repo.GetUserBySystemId(ctx, userSystemId)
+// GetAssignmentExplanation tells for every reviewer selection made on a pull
+// request whom it looked at, whom it left out and why, and whom it picked.
+func (u *UseCase) GetAssignmentExplanation(ctx context.Context, prSystemId string) (*models.AssignmentExplanationsDTO, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, prSystemId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetAssignmentExplanation", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	if user == nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] GetAssignmentExplanation", appErrors.ErrResourceNotFound.Error())
 		return nil, appErrors.ErrResourceNotFound
 	}
 
-	reviews, err := u.repo.GetListReviewsByUserId(ctx, user.UserId)
+	explanations, err := u.repo.GetAssignmentExplanations(ctx, pr.PullRequestId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetAssignmentExplanation", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	reviewDto := &models.ReviewDTO{
-		UserId:      user.SystemId,
-		PullRequest: make([]models.PullRequestShortDTO, 0, len(reviews)),
+	out := &models.AssignmentExplanationsDTO{
+		PullRequestId: pr.SystemId,
+		Assignments:   make([]models.AssignmentExplanationDTO, 0, len(explanations)),
 	}
 
-	for _, pr := range reviews {
-		reviewDto.PullRequest = append(reviewDto.PullRequest, models.PullRequestShortDTO{
-			PullRequestId:   pr.SystemId,
-			PullRequestName: pr.PullRequestName,
-			AuthorId:        pr.AuthorSystemId,
-			Status:          pr.Status,
-		})
+	for _, e := range explanations {
+		out.Assignments = append(out.Assignments, explanationToDto(e))
 	}
 
-	logs.PrintLog(ctx, "[usecase] GetReview", fmt.Sprintf("Member found: %+v", user.SystemId))
-	return reviewDto, nil
+	logs.PrintLog(ctx, "[usecase] GetAssignmentExplanation", fmt.Sprintf("Explanations found: %+v count: %+v", pr.SystemId, len(explanations)))
+	return out, nil
 }
 
-func (u *UseCase) CreatePullRequest(ctx context.Context, dto *models.InputCreatePullRequestDTO) (*models.OutputCreatePullRequestDTO, error) {
-	exists, err := u.repo.PullRequestExists(ctx, dto.PullRequestId)
+func parseTimeBound(value string) (sql.NullTime, error) {
+	if value == "" {
+		return sql.NullTime{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
-		return nil, appErrors.ErrServerError
+		return sql.NullTime{}, appErrors.ErrParseData
 	}
 
-	if exists {
-		logs.PrintLog(ctx, "[usecase] CreatePullRequest", appErrors.ErrPullRequestExists.Error())
-		return nil, appErrors.ErrPullRequestExists
+	return sql.NullTime{Time: t.UTC(), Valid: true}, nil
+}
+
+func (u *UseCase) GetAssignmentStats(ctx context.Context, dto *models.InputAssignmentStatsDTO) (*models.AssignmentStatsDTO, error) {
+	from, err := parseTimeBound(dto.From)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] GetAssignmentStats", fmt.Sprintf("Invalid from: %+v", dto.From))
+		return nil, err
 	}
 
-	user, err := u.repo.GetUserBySystemId(ctx, dto.AuthorId)
+	to, err := parseTimeBound(dto.To)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
-		return nil, appErrors.ErrServerError
+		logs.PrintLog(ctx, "[usecase] GetAssignmentStats", fmt.Sprintf("Invalid to: %+v", dto.To))
+		return nil, err
 	}
 
-	if user == nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
-		return nil, appErrors.ErrResourceNotFound
+	if from.Valid && to.Valid && !from.Time.Before(to.Time) {
+		logs.PrintLog(ctx, "[usecase] GetAssignmentStats", "Empty time window")
+		return nil, appErrors.ErrParseData
 	}
 
-	members, err := u.repo.GetTeamMembers(ctx, user.TeamId)
+	stats, err := u.repo.GetReviewerStats(ctx, from, to, dto.TeamName)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetAssignmentStats", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	var candidates []*models.User
+	statsDto := &models.AssignmentStatsDTO{
+		From:  dto.From,
+		To:    dto.To,
+		Users: make([]models.UserAssignmentStatsDTO, 0, len(stats)),
+		Teams: make([]models.TeamAssignmentStatsDTO, 0),
+	}
 
-	for _, member := range members {
-		if member.SystemId == dto.AuthorId {
+	teamIndex := make(map[string]int)
+	for _, s := range stats {
+		statsDto.Users = append(statsDto.Users, models.UserAssignmentStatsDTO{
+			UserId:   s.UserSystemId,
+			UserName: s.UserName,
+			TeamName: s.TeamName,
+			Assigned: s.Assigned,
+			Open:     s.Open,
+			Merged:   s.Merged,
+		})
+
+		// users removed from their team are listed only per user
+		if s.TeamName == "" {
 			continue
 		}
-		if member.IsActive {
-			candidates = append(candidates, member)
-		}
-	}
 
-	var reviewers []*models.User
-	switch len(candidates) {
-	case 0:
-		// no one to review
-	case 1:
-		reviewers = append(reviewers, candidates[0])
-	default:
-		rand.Shuffle(len(candidates), func(i, j int) {
-			candidates[i], candidates[j] = candidates[j], candidates[i]
-		})
+		i, ok := teamIndex[s.TeamName]
+		if !ok {
+			i = len(statsDto.Teams)
+			teamIndex[s.TeamName] = i
+			statsDto.Teams = append(statsDto.Teams, models.TeamAssignmentStatsDTO{TeamName: s.TeamName})
+		}
 
-		reviewers = append(reviewers, candidates[0], candidates[1])
+		team := &statsDto.Teams[i]
+		team.Members++
+		team.Assigned += s.Assigned
+		team.Open += s.Open
+		team.Merged += s.Merged
 	}
 
-	logs.PrintLog(ctx, "[usecase] CreatePullRequest", fmt.Sprintf("Reviewers: %+v", reviewers))
+	logs.PrintLog(ctx, "[usecase] GetAssignmentStats", fmt.Sprintf("Stats found for users: %+v teams: %+v", len(statsDto.Users), len(statsDto.Teams)))
+	return statsDto, nil
+}
 
-	pr := &models.PullRequest{
-		SystemId:        dto.PullRequestId,
-		PullRequestName: dto.PullRequestName,
-		AuthorId:        user.UserId,
-		AuthorSystemId:  user.SystemId,
-		Status:          "OPEN",
+// validateWebhook checks the subscription and returns its event types
+// deduped and sorted.
+func validateWebhook(dto *models.WebhookDTO) ([]string, error) {
+	target, err := url.Parse(dto.Url)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, appErrors.ErrInvalidWebhook
 	}
 
-	err = u.repo.CreatePullRequestAndReview(ctx, pr, reviewers)
-	if err != nil {
-		logs.PrintLog(ctx, "[usecase] CreatePullRequest", err.Error())
-		return nil, appErrors.ErrServerError
+	if dto.Secret == "" || len(dto.EventTypes) == 0 {
+		return nil, appErrors.ErrInvalidWebhook
 	}
 
-	prDto := &models.OutputCreatePullRequestDTO{
-		PullRequestID:     pr.SystemId,
-		PullRequestName:   pr.PullRequestName,
-		AuthorID:          pr.AuthorSystemId,
-		Status:            pr.Status,
-		AssignedReviewers: make([]string, 0, len(reviewers)),
+	known := make(map[string]bool, len(models.WebhookEventTypes))
+	for _, eventType := range models.WebhookEventTypes {
+		known[eventType] = true
 	}
 
-	for _, reviewer := range reviewers {
-		prDto.AssignedReviewers = append(prDto.AssignedReviewers, reviewer.SystemId)
+	eventTypes := make([]string, 0, len(dto.EventTypes))
+	for _, eventType := range dto.EventTypes {
+		if !known[eventType] {
+			return nil, appErrors.ErrInvalidWebhook
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
 	}
 
-	return prDto, nil
+	sort.Strings(eventTypes)
+	return eventTypes, nil
 }
 
-func (u *UseCase) MergePullRequest(ctx context.Context, dto *models.InputMergePullRequestDTO) (*models.OutputMergePullRequestDTO, error) {
-	pr, err := u.repo.GetPullRequestById(ctx, dto.PullRequestId)
-	if err != nil {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
-		return nil, appErrors.ErrServerError
+// webhookToDto leaves the secret out, it is only ever set by the caller.
+func webhookToDto(s *models.WebhookSubscription) models.WebhookDTO {
+	return models.WebhookDTO{
+		SubscriptionId: s.SubscriptionId,
+		Url:            s.Url,
+		EventTypes:     s.EventTypes,
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339),
 	}
+}
 
-	if pr == nil {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", appErrors.ErrResourceNotFound.Error())
-		return nil, appErrors.ErrResourceNotFound
+func (u *UseCase) AddWebhook(ctx context.Context, dto *models.WebhookDTO) (*models.WebhookDTO, error) {
+	eventTypes, err := validateWebhook(dto)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] AddWebhook", err.Error())
+		return nil, err
 	}
 
-	if pr.Status == "MERGED" {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", fmt.Sprintf("Pull request is already merged: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
-		prDto := &models.OutputMergePullRequestDTO{
-			PullRequestID:     pr.SystemId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorID:          pr.AuthorSystemId,
-			Status:            pr.Status,
-			AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
-		}
-
-		prDto.MergedAt = pr.MergedAt.Time.Format(time.RFC3339)
-
-		for _, r := range pr.AssigneeReviewers {
-			prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
-		}
+	subscription := &models.WebhookSubscription{
+		Url:        dto.Url,
+		Secret:     dto.Secret,
+		EventTypes: eventTypes,
+	}
 
-		return prDto, nil
+	if err := u.repo.CreateWebhookSubscription(ctx, subscription); err != nil {
+		logs.PrintLog(ctx, "[usecase] AddWebhook", err.Error())
+		return nil, appErrors.ErrServerError
 	}
 
-	pr.Status = "MERGED"
-	logs.PrintLog(ctx, "[usecase] MergePullRequest", fmt.Sprintf("Pull request is merged first time: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
+	out := webhookToDto(subscription)
+	logs.PrintLog(ctx, "[usecase] AddWebhook", fmt.Sprintf("Webhook added: %+v events: %+v", subscription.SubscriptionId, eventTypes))
+	return &out, nil
+}
 
-	mergedTime, err := u.repo.SetMergedStatusPullRequest(ctx, pr.PullRequestId)
+func (u *UseCase) GetWebhooks(ctx context.Context) (*models.WebhooksDTO, error) {
+	subscriptions, err := u.repo.GetWebhookSubscriptions(ctx)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetWebhooks", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	prDto := &models.OutputMergePullRequestDTO{
-		PullRequestID:     pr.SystemId,
-		PullRequestName:   pr.PullRequestName,
-		AuthorID:          pr.AuthorSystemId,
-		Status:            pr.Status,
-		AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
+	out := &models.WebhooksDTO{Webhooks: make([]models.WebhookDTO, 0, len(subscriptions))}
+	for _, s := range subscriptions {
+		out.Webhooks = append(out.Webhooks, webhookToDto(s))
 	}
 
-	prDto.MergedAt = mergedTime.Time.Format(time.RFC3339)
+	return out, nil
+}
 
-	for _, r := range pr.AssigneeReviewers {
-		prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+func (u *UseCase) RemoveWebhook(ctx context.Context, dto *models.RemoveWebhookDTO) error {
+	deleted, err := u.repo.DeleteWebhookSubscription(ctx, dto.SubscriptionId)
+	if err != nil {
+		logs.PrintLog(ctx, "[usecase] RemoveWebhook", err.Error())
+		return appErrors.ErrServerError
 	}
 
-	return prDto, nil
+	if !deleted {
+		logs.PrintLog(ctx, "[usecase] RemoveWebhook", appErrors.ErrResourceNotFound.Error())
+		return appErrors.ErrResourceNotFound
+	}
+
+	logs.PrintLog(ctx, "[usecase] RemoveWebhook", fmt.Sprintf("Webhook removed: %+v", dto.SubscriptionId))
+	return nil
 }
 
-func (u *UseCase) Reassign(ctx context.Context, dto *models.InputReassignDTO) (*models.OutputReassignDTO, error) {
-	pr, err := u.repo.GetPullRequestById(ctx, dto.PullRequestId)
+func (u *UseCase) GetWebhookDeadLetters(ctx context.Context) (*models.WebhookDeadLettersDTO, error) {
+	deliveries, err := u.repo.GetDeadWebhookDeliveries(ctx)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", err.Error())
+		logs.PrintLog(ctx, "[usecase] GetWebhookDeadLetters", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	if pr == nil {
-		logs.PrintLog(ctx, "[usecase] MergePullRequest", appErrors.ErrResourceNotFound.Error())
-		return nil, appErrors.ErrResourceNotFound
+	out := &models.WebhookDeadLettersDTO{Deliveries: make([]models.WebhookDeliveryDTO, 0, len(deliveries))}
+	for _, d := range deliveries {
+		out.Deliveries = append(out.Deliveries, models.WebhookDeliveryDTO{
+			DeliveryId:     d.DeliveryId,
+			SubscriptionId: d.SubscriptionId,
+			Url:            d.Url,
+			EventType:      d.EventType,
+			Payload:        d.Payload,
+			Attempts:       d.Attempts,
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+			DeadAt:         d.DeadAt.Time.Format(time.RFC3339),
+		})
 	}
 
-	user, err := u.repo.GetUserBySystemId(ctx, dto.UserId)
+	return out, nil
+}
+
+// DispatchWebhooks sends a batch of due deliveries from the outbox. A failed
+// delivery is retried with exponential backoff and goes to the dead letters
+// after webhook.MaxAttempts tries.
+func (u *UseCase) DispatchWebhooks(ctx context.Context) error {
+	deliveries, err := u.repo.ClaimWebhookDeliveries(ctx, webhookBatchSize, webhookLease)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
-		return nil, appErrors.ErrServerError
+		logs.PrintLog(ctx, "[usecase] DispatchWebhooks", err.Error())
+		return appErrors.ErrServerError
 	}
 
-	if user == nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", appErrors.ErrResourceNotFound.Error())
-		return nil, appErrors.ErrResourceNotFound
-	}
+	for _, d := range deliveries {
+		sendErr := u.webhooks.Send(ctx, d)
+
+		switch {
+		case sendErr == nil:
+			err = u.repo.MarkWebhookDelivered(ctx, d.DeliveryId)
+		case d.Attempts >= webhook.MaxAttempts:
+			logs.PrintLog(ctx, "[usecase] DispatchWebhooks", fmt.Sprintf("Delivery %+v is dead after %+v attempts: %+v", d.DeliveryId, d.Attempts, sendErr))
+			err = u.repo.MarkWebhookDead(ctx, d.DeliveryId, sendErr.Error())
+		default:
+			logs.PrintLog(ctx, "[usecase] DispatchWebhooks", fmt.Sprintf("Delivery %+v failed, attempt %+v: %+v", d.DeliveryId, d.Attempts, sendErr))
+			err = u.repo.RetryWebhookDelivery(ctx, d.DeliveryId, sendErr.Error(), webhook.Backoff(d.Attempts))
+		}
 
-	if pr.Status == "MERGED" {
-		logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("Pull request is already merged: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
-		return nil, appErrors.ErrPullRequestMerged
+		if err != nil {
+			logs.PrintLog(ctx, "[usecase] DispatchWebhooks", err.Error())
+			return appErrors.ErrServerError
+		}
 	}
 
-	IsUserReviewThisPR := false
-	var otherReviewer *models.User
-	for _, r := range pr.AssigneeReviewers {
-		if r.SystemId == dto.UserId {
-			IsUserReviewThisPR = true
-			continue
+	return nil
+}
+
+func (u *UseCase) RunWebhookDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = u.DispatchWebhooks(ctx)
 		}
-		otherReviewer = r
 	}
+}
 
-	if !IsUserReviewThisPR {
-		// return pr without replace
-		prDto := &models.OutputReassignDTO{
-			PullRequestID:     pr.SystemId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorID:          pr.AuthorSystemId,
-			Status:            pr.Status,
-			AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
-			ReplacedBy:        "-",
+// applyPullRequestEvent drives the usecase method matching an inbound event.
+// The host has merged the PR already, so the merge bypasses the team merge
+// policy the way an admin force merge does.
+func (u *UseCase) applyPullRequestEvent(ctx context.Context, event *models.InboundPullRequestEvent) error {
+	var err error
+	status := &models.InputChangeStatusPullRequestDTO{PullRequestId: event.PullRequestId}
+
+	switch event.Action {
+	case models.InboundOpened:
+		_, err = u.CreatePullRequest(ctx, &models.InputCreatePullRequestDTO{
+			PullRequestId:   event.PullRequestId,
+			PullRequestName: event.Title,
+			AuthorId:        event.AuthorLogin,
+			Draft:           event.Draft,
+		})
+	case models.InboundReady:
+		_, err = u.MarkReadyPullRequest(ctx, status)
+	case models.InboundMerged:
+		_, err = u.MergePullRequest(actor.WithAdmin(ctx, true), &models.InputMergePullRequestDTO{PullRequestId: event.PullRequestId, Force: true})
+	case models.InboundClosed:
+		_, err = u.ClosePullRequest(ctx, status)
+	case models.InboundReopened:
+		_, err = u.ReopenPullRequest(ctx, status)
+	case models.InboundReviewRequested:
+		// the PR has to leave draft first, reviewers of a draft can't change
+		readied := false
+		if event.Ready {
+			_, err = u.MarkReadyPullRequest(ctx, status)
+			if err != nil && !sameStatus(err) {
+				return err
+			}
+			readied = err == nil
 		}
 
-		for _, r := range pr.AssigneeReviewers {
-			prDto.AssignedReviewers = append(prDto.AssignedReviewers, r.SystemId)
+		var reviewerIds []string
+		reviewerIds, err = u.unassignedReviewers(ctx, event.PullRequestId, event.RequestedReviewers)
+		if err != nil {
+			return err
 		}
 
-		logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("User is not assigned to pull request: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
-		return prDto, nil
+		if len(reviewerIds) == 0 {
+			if readied {
+				return nil
+			}
+			return appErrors.ErrReviewerAlreadyAssigned
+		}
+
+		_, err = u.AddReviewers(ctx, &models.InputChangeReviewersDTO{PullRequestId: event.PullRequestId, ReviewerIds: reviewerIds})
 	}
 
-	members, err := u.repo.GetTeamMembers(ctx, user.TeamId)
+	return err
+}
+
+// unassignedReviewers drops the reviewers already assigned to the PR, code
+// hosts send the whole reviewer list rather than the added ones.
+func (u *UseCase) unassignedReviewers(ctx context.Context, prSystemId string, reviewerIds []string) ([]string, error) {
+	pr, err := u.repo.GetPullRequestById(ctx, prSystemId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] GetReview", err.Error())
+		logs.PrintLog(ctx, "[usecase] unassignedReviewers", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	var candidates []*models.User
-	for _, member := range members {
-		if member.SystemId == pr.AuthorSystemId || member.SystemId == dto.UserId {
-			continue
-		}
+	if pr == nil {
+		logs.PrintLog(ctx, "[usecase] unassignedReviewers", appErrors.ErrResourceNotFound.Error())
+		return nil, appErrors.ErrResourceNotFound
+	}
 
-		if otherReviewer != nil && member.SystemId == otherReviewer.SystemId {
-			continue
-		}
-		if member.IsActive {
-			candidates = append(candidates, member)
-		}
+	assigned := make(map[string]bool, len(pr.AssigneeReviewers))
+	for _, r := range pr.AssigneeReviewers {
+		assigned[r.SystemId] = true
 	}
 
-	if len(candidates) == 0 {
-		err = u.repo.DeleteReview(ctx, pr.PullRequestId, user.UserId)
-		if err != nil {
-			logs.PrintLog(ctx, "[usecase] Reassign", err.Error())
-			return nil, appErrors.ErrServerError
+	unassigned := make([]string, 0, len(reviewerIds))
+	for _, id := range reviewerIds {
+		if !assigned[id] {
+			unassigned = append(unassigned, id)
 		}
+	}
 
-		prDto := &models.OutputReassignDTO{
-			PullRequestID:     pr.SystemId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorID:          pr.AuthorSystemId,
-			Status:            pr.Status,
-			AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
-			ReplacedBy:        "-",
-		}
+	return unassigned, nil
+}
 
-		if otherReviewer != nil {
-			prDto.AssignedReviewers = append(prDto.AssignedReviewers, otherReviewer.SystemId)
-		}
+// inboundActionSupported tells whether the service acts on an inbound event.
+func inboundActionSupported(event *models.InboundPullRequestEvent) bool {
+	switch event.Action {
+	case models.InboundOpened, models.InboundReady, models.InboundMerged, models.InboundClosed, models.InboundReopened:
+		return true
+	case models.InboundReviewRequested:
+		return len(event.RequestedReviewers) > 0
+	}
+	return false
+}
 
-		return prDto, nil
+// sameStatus tells whether err rejects moving a PR to the status it already
+// has, e.g. closing a closed one.
+func sameStatus(err error) bool {
+	var transitionErr *appErrors.StatusTransitionError
+	return errors.As(err, &transitionErr) && transitionErr.From == transitionErr.To
+}
 
-	} else {
-		rand.Shuffle(len(candidates), func(i, j int) {
-			candidates[i], candidates[j] = candidates[j], candidates[i]
-		})
+// HandlePullRequestEvent applies a pull request webhook of a code host once
+// per delivery. A redelivery, or an event the service is already in sync
+// with, is answered as a duplicate; a delivery that failed is forgotten, so
+// that the host can redeliver it.
+func (u *UseCase) HandlePullRequestEvent(ctx context.Context, event *models.InboundPullRequestEvent) (*models.InboundWebhookResultDTO, error) {
+	out := &models.InboundWebhookResultDTO{
+		DeliveryId:    event.DeliveryId,
+		Action:        event.Action,
+		PullRequestId: event.PullRequestId,
+		Result:        models.InboundProcessed,
+	}
+
+	if !inboundActionSupported(event) {
+		logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", fmt.Sprintf("Ignored %+v delivery %+v: %+v", event.Provider, event.DeliveryId, event.Action))
+		out.Result = models.InboundIgnored
+		return out, nil
 	}
 
-	err = u.repo.ReplaceReviewers(ctx, pr.PullRequestId, user.UserId, candidates[0].UserId)
+	fresh, err := u.repo.RecordInboundDelivery(ctx, event.Provider, event.DeliveryId)
 	if err != nil {
-		logs.PrintLog(ctx, "[usecase] Reassign", err.Error())
+		logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", err.Error())
 		return nil, appErrors.ErrServerError
 	}
 
-	prDto := &models.OutputReassignDTO{
-		PullRequestID:     pr.SystemId,
-		PullRequestName:   pr.PullRequestName,
-		AuthorID:          pr.AuthorSystemId,
-		Status:            pr.Status,
-		AssignedReviewers: make([]string, 0, len(pr.AssigneeReviewers)),
-		ReplacedBy:        candidates[0].SystemId,
+	if !fresh {
+		logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", fmt.Sprintf("Duplicate %+v delivery %+v", event.Provider, event.DeliveryId))
+		out.Result = models.InboundDuplicate
+		return out, nil
 	}
 
-	prDto.AssignedReviewers = append(prDto.AssignedReviewers, candidates[0].SystemId)
+	err = u.applyPullRequestEvent(actor.WithActor(ctx, event.Provider+"-webhook"), event)
+	if errors.Is(err, appErrors.ErrPullRequestExists) || errors.Is(err, appErrors.ErrReviewerAlreadyAssigned) || sameStatus(err) {
+		out.Result = models.InboundDuplicate
+		err = nil
+	}
 
-	if otherReviewer != nil {
-		prDto.AssignedReviewers = append(prDto.AssignedReviewers, otherReviewer.SystemId)
+	if err != nil {
+		if forgetErr := u.repo.ForgetInboundDelivery(ctx, event.Provider, event.DeliveryId); forgetErr != nil {
+			logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", forgetErr.Error())
+		}
+		logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", err.Error())
+		return nil, err
 	}
 
-	logs.PrintLog(ctx, "[usecase] Reassign", fmt.Sprintf("Reassigned pull request: name %+v id %+v", dto.PullRequestId, pr.PullRequestId))
-	return prDto, nil
+	logs.PrintLog(ctx, "[usecase] HandlePullRequestEvent", fmt.Sprintf("Applied %+v delivery %+v: %+v %+v", event.Provider, event.DeliveryId, event.Action, event.PullRequestId))
+	return out, nil
 }
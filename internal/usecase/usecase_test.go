@@ -4,10 +4,17 @@ import (
 	"PRmanager/internal/models"
 	"PRmanager/internal/repository/mocks"
 	"PRmanager/internal/usecase"
+	"PRmanager/internal/usecase/notify"
+	"PRmanager/internal/usecase/webhook"
+	"PRmanager/pkg/actor"
 	appErrors "PRmanager/pkg/app_errors"
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -15,6 +22,10 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func intPtr(v int) *int {
+	return &v
+}
+
 func TestUseCase_AddTeam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -40,11 +51,27 @@ func TestUseCase_AddTeam(t *testing.T) {
 						assert.Len(t, team.TeamMembers, 2)
 						assert.Equal(t, "u1", team.TeamMembers[0].SystemId)
 						assert.Equal(t, "Nick", team.TeamMembers[0].UserName)
+						assert.Equal(t, "random", team.ReviewerStrategy)
+						assert.Equal(t, 0, team.MinReviewers)
+						assert.Equal(t, 2, team.MaxReviewers)
+						assert.Equal(t, 1, team.TeamMembers[0].ReviewWeight)
 						return nil
 					})
 			},
 			expectedErr: nil,
 		},
+		{
+			name:        "unknown reviewer strategy",
+			dto:         &models.TeamDTO{TeamName: "backend", ReviewerStrategy: "lottery"},
+			mockSetup:   func(m *mocks.MockRepositoryInterface) {},
+			expectedErr: appErrors.ErrUnknownStrategy,
+		},
+		{
+			name:        "invalid reviewers count",
+			dto:         &models.TeamDTO{TeamName: "backend", MinReviewers: intPtr(3), MaxReviewers: intPtr(2)},
+			mockSetup:   func(m *mocks.MockRepositoryInterface) {},
+			expectedErr: appErrors.ErrInvalidReviewersCount,
+		},
 		{
 			name: "team exists",
 			dto:  &models.TeamDTO{TeamName: "backend"},
@@ -115,7 +142,7 @@ func TestUseCase_GetTeamByName(t *testing.T) {
 					Return(&models.Team{
 						TeamName: "backend",
 						TeamMembers: []*models.User{
-							{SystemId: "u1", UserName: "Nick", IsActive: true},
+							{SystemId: "u1", UserName: "Nick", IsActive: true, MaxOpenReviews: intPtr(3), OpenReviews: 2},
 							{SystemId: "u2", UserName: "Sara", IsActive: false},
 						},
 					}, nil)
@@ -123,8 +150,8 @@ func TestUseCase_GetTeamByName(t *testing.T) {
 			expected: &models.TeamDTO{
 				TeamName: "backend",
 				Members: []models.MemberDTO{
-					{UserID: "u1", Username: "Nick", IsActive: true},
-					{UserID: "u2", Username: "Sara", IsActive: false},
+					{UserID: "u1", Username: "Nick", IsActive: true, MaxOpenReviews: intPtr(3), OpenReviews: intPtr(2)},
+					{UserID: "u2", Username: "Sara", IsActive: false, OpenReviews: intPtr(0)},
 				},
 			},
 			expectedErr: nil,
@@ -179,6 +206,71 @@ func TestUseCase_GetTeamByName(t *testing.T) {
 	}
 }
 
+func TestUseCase_SetReviewerStrategy(t *testing.T) {
+	tests := []struct {
+		name        string
+		dto         *models.SetReviewerStrategyDTO
+		mockSetup   func(m *mocks.MockRepositoryInterface)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			dto:  &models.SetReviewerStrategyDTO{TeamName: "backend", ReviewerStrategy: "round_robin"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetTeamReviewerStrategy(gomock.Any(), "backend", "round_robin").Return(true, nil)
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").
+					Return(&models.Team{TeamName: "backend", ReviewerStrategy: "round_robin"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "unknown strategy",
+			dto:         &models.SetReviewerStrategyDTO{TeamName: "backend", ReviewerStrategy: "lottery"},
+			mockSetup:   func(m *mocks.MockRepositoryInterface) {},
+			expectedErr: appErrors.ErrUnknownStrategy,
+		},
+		{
+			name: "team not found",
+			dto:  &models.SetReviewerStrategyDTO{TeamName: "backend", ReviewerStrategy: "weighted"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetTeamReviewerStrategy(gomock.Any(), "backend", "weighted").Return(false, nil)
+			},
+			expectedErr: appErrors.ErrResourceNotFound,
+		},
+		{
+			name: "repo error",
+			dto:  &models.SetReviewerStrategyDTO{TeamName: "backend", ReviewerStrategy: "weighted"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetTeamReviewerStrategy(gomock.Any(), "backend", "weighted").Return(false, errors.New("db"))
+			},
+			expectedErr: appErrors.ErrServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			result, err := uc.SetReviewerStrategy(context.Background(), tt.dto)
+
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.dto.ReviewerStrategy, result.ReviewerStrategy)
+			} else {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
+
 func TestUseCase_SetIsActive(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -201,6 +293,7 @@ func TestUseCase_SetIsActive(t *testing.T) {
 						UserName: "Nick",
 						TeamName: "backend",
 						IsActive: true,
+						Tags:     []string{"go"},
 					}, nil)
 			},
 			expected: &models.UserDTO{
@@ -208,6 +301,7 @@ func TestUseCase_SetIsActive(t *testing.T) {
 				UserName: "Nick",
 				TeamName: "backend",
 				IsActive: true,
+				Tags:     []string{"go"},
 			},
 			expectedErr: nil,
 		},
@@ -301,6 +395,9 @@ func TestUseCase_GetReview(t *testing.T) {
 							PullRequestName: "Refactor",
 							AuthorSystemId:  "u3",
 							Status:          "MERGED",
+							Decisions: map[int]*models.ReviewDecision{
+								10: {Decision: "APPROVED", DecidedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+							},
 						},
 					}, nil)
 			},
@@ -312,12 +409,15 @@ func TestUseCase_GetReview(t *testing.T) {
 						PullRequestName: "Fix Bug",
 						AuthorId:        "u2",
 						Status:          "OPEN",
+						Decision:        "PENDING",
 					},
 					{
 						PullRequestId:   "PR2",
 						PullRequestName: "Refactor",
 						AuthorId:        "u3",
 						Status:          "MERGED",
+						Decision:        "APPROVED",
+						DecidedAt:       "2025-01-02T03:04:05Z",
 					},
 				},
 			},
@@ -447,6 +547,21 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				assert.Equal(t, appErrors.ErrResourceNotFound, err)
 			},
 		},
+		{
+			name: "error GetTeamById",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+					Return(&models.User{UserId: 10, TeamId: 99, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 99).
+					Return(nil, errors.New("db"))
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrServerError, err)
+			},
+		},
 		{
 			name: "error GetTeamMembers",
 			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"},
@@ -454,6 +569,8 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
 				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
 					Return(&models.User{UserId: 10, TeamId: 99, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 99).
+					Return(&models.Team{TeamId: 99, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
 				m.EXPECT().GetTeamMembers(gomock.Any(), 99).
 					Return(nil, errors.New("db"))
 			},
@@ -469,13 +586,17 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
 				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
 					Return(&models.User{UserId: 10, TeamId: 5, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
 				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
 					Return([]*models.User{{SystemId: "u1", IsActive: true}}, nil)
-				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User) error {
+				m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return(nil, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User, _ []*models.PrEvent) error {
 						assert.Len(t, reviewers, 0)
 						return nil
 					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
 				assert.NoError(t, err)
@@ -489,17 +610,22 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
 				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
 					Return(&models.User{UserId: 10, TeamId: 5, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
 				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
 					Return([]*models.User{
 						{SystemId: "u1"},
 						{SystemId: "u2", IsActive: true},
 					}, nil)
-				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User) error {
+				m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return(nil, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr *models.PullRequest, reviewers []*models.User, _ []*models.PrEvent) error {
+						assert.Equal(t, 5, pr.AuthorTeamId)
 						assert.Len(t, reviewers, 1)
 						assert.Equal(t, "u2", reviewers[0].SystemId)
 						return nil
 					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
 				assert.NoError(t, err)
@@ -515,6 +641,8 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
 					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
 
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
 				m.EXPECT().GetTeamMembers(gomock.Any(), 9).
 					Return([]*models.User{
 						{SystemId: "u1"},
@@ -523,15 +651,137 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 					}, nil)
 
 				m.EXPECT().
-					CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User) error {
+					CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User, events []*models.PrEvent) error {
 						assert.Len(t, reviewers, 2)
+						assert.Equal(t, int64(7), *events[1].Seed)
+						return nil
+					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *models.AssignmentExplanation) error {
+						assert.Equal(t, models.AssignmentCreated, e.Trigger)
+						assert.Equal(t, "random", e.Strategy)
+						assert.Equal(t, int64(7), *e.Seed)
+						assert.Equal(t, []*models.AssignmentCandidate{
+							{SystemId: "u1", ExcludedReason: models.ExcludedAuthor},
+							{SystemId: "u2", Selected: true, Pool: models.PoolHome},
+							{SystemId: "u3", Selected: true, Pool: models.PoolHome},
+						}, e.Candidates)
+						return nil
+					})
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u3", "u2"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "reviewers count override",
+			dto: &models.InputCreatePullRequestDTO{
+				PullRequestId:  "PR1",
+				AuthorId:       "u1",
+				ReviewersCount: intPtr(3),
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MinReviewers: 3, MaxReviewers: 4}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 9).
+					Return([]*models.User{
+						{SystemId: "u1", IsActive: true},
+						{SystemId: "u2", IsActive: true},
+						{SystemId: "u3", IsActive: true},
+						{SystemId: "u4", IsActive: true},
+						{SystemId: "u5", IsActive: true},
+					}, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User, _ []*models.PrEvent) error {
+						assert.Len(t, reviewers, 3)
 						return nil
 					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u5", "u3", "u2"}, out.AssignedReviewers)
+				assert.False(t, out.NotEnoughReviewers)
+			},
+		},
+		{
+			name: "reviewers count out of team limits",
+			dto: &models.InputCreatePullRequestDTO{
+				PullRequestId:  "PR1",
+				AuthorId:       "u1",
+				ReviewersCount: intPtr(5),
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MinReviewers: 1, MaxReviewers: 4}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 9).Return([]*models.User{}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrInvalidReviewersCount, err)
+			},
+		},
+		{
+			name: "not enough candidates for team minimum",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MinReviewers: 2, MaxReviewers: 3}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 9).
+					Return([]*models.User{
+						{SystemId: "u1", IsActive: true},
+						{SystemId: "u2", IsActive: true},
+					}, nil)
+				m.EXPECT().GetPartnerTeams(gomock.Any(), 9).Return(nil, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u2"}, out.AssignedReviewers)
+				assert.Equal(t, 2, out.RequiredReviewers)
+			},
+		},
+		{
+			name: "least loaded strategy",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
+
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "least_loaded", MaxReviewers: 2}, nil)
+
+				m.EXPECT().GetTeamMembers(gomock.Any(), 9).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+						{UserId: 12, SystemId: "u3", IsActive: true},
+						{UserId: 13, SystemId: "u4", IsActive: true},
+					}, nil)
+
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{11, 12, 13}).
+					Return(map[int]int{11: 2, 13: 1}, nil)
+
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
 				assert.NoError(t, err)
-				assert.Len(t, out.AssignedReviewers, 2)
+				assert.Equal(t, []string{"u3", "u4"}, out.AssignedReviewers)
 			},
 		},
 		{
@@ -543,10 +793,13 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
 					Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
 
+				m.EXPECT().GetTeamById(gomock.Any(), 9).
+					Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
 				m.EXPECT().GetTeamMembers(gomock.Any(), 9).
 					Return([]*models.User{}, nil)
 
-				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any()).
+				m.EXPECT().GetPartnerTeams(gomock.Any(), 9).Return(nil, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("db"))
 			},
 			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
@@ -563,7 +816,7 @@ func TestUseCase_CreatePullRequest(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
-			uc := usecase.NewUseCase(mockRepo)
+			uc := usecase.NewUseCase(mockRepo, usecase.WithSeeds(usecase.FixedSeed(7)))
 
 			tt.mockSetup(mockRepo)
 
@@ -616,6 +869,7 @@ func TestUseCase_MergePullRequest(t *testing.T) {
 						SystemId:        "PR1",
 						PullRequestName: "Fix bug",
 						AuthorSystemId:  "u1",
+						AuthorTeamId:    5,
 						Status:          "MERGED",
 						AssigneeReviewers: []*models.User{
 							{SystemId: "u2"},
@@ -623,6 +877,8 @@ func TestUseCase_MergePullRequest(t *testing.T) {
 						},
 						MergedAt: sql.NullTime{Time: now},
 					}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, MinReviewers: 1, MaxReviewers: 2}, nil)
 			},
 			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
 				assert.NoError(t, err)
@@ -630,6 +886,31 @@ func TestUseCase_MergePullRequest(t *testing.T) {
 				assert.Equal(t, "MERGED", out.Status)
 				assert.ElementsMatch(t, []string{"u2", "u3"}, out.AssignedReviewers)
 				assert.NotEmpty(t, out.MergedAt)
+				assert.False(t, out.NotEnoughReviewers)
+			},
+		},
+		{
+			name: "merge PR with fewer reviewers than required",
+			dto:  &models.InputMergePullRequestDTO{PullRequestId: "PR1"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{
+						PullRequestId:     1,
+						SystemId:          "PR1",
+						AuthorSystemId:    "u1",
+						AuthorTeamId:      5,
+						Status:            "OPEN",
+						AssigneeReviewers: []*models.User{{SystemId: "u2"}},
+					}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, MinReviewers: 2, MaxReviewers: 2}, nil)
+				m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Any()).
+					Return(sql.NullTime{Time: time.Now(), Valid: true}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "MERGED", out.Status)
+				assert.Equal(t, 2, out.RequiredReviewers)
 			},
 		},
 	}
@@ -650,3 +931,3281 @@ func TestUseCase_MergePullRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_Reassign(t *testing.T) {
+	openPR := func() *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:  1,
+			SystemId:       "PR1",
+			AuthorSystemId: "u1",
+			AuthorTeamId:   5,
+			Status:         "OPEN",
+			AssigneeReviewers: []*models.User{
+				{UserId: 11, SystemId: "u2"},
+				{UserId: 12, SystemId: "u3"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dto       *models.InputReassignDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.OutputReassignDTO, err error)
+	}{
+		{
+			name: "PR not found",
+			dto:  &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.OutputReassignDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "PR merged",
+			dto:  &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := openPR()
+				pr.Status = "MERGED"
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+					Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputReassignDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrPullRequestMerged, err)
+			},
+		},
+		{
+			name: "no candidates drops reviewer",
+			dto:  &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+					Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", MinReviewers: 2, MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+						{UserId: 12, SystemId: "u3", IsActive: true},
+					}, nil)
+				m.EXPECT().DeleteReview(gomock.Any(), 1, 11, gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputReassignDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "-", out.ReplacedBy)
+				assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "least loaded replacement",
+			dto:  &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+					Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, ReviewerStrategy: "least_loaded", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+						{UserId: 12, SystemId: "u3", IsActive: true},
+						{UserId: 14, SystemId: "u5", IsActive: true},
+						{UserId: 13, SystemId: "u4", IsActive: true},
+					}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{14, 13}).
+					Return(map[int]int{14: 1, 13: 1}, nil)
+				m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 11, 13, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, _ int, _ int, events []*models.PrEvent) error {
+						assert.Len(t, events, 1)
+						assert.Equal(t, models.EventReviewerReplaced, events[0].EventType)
+						assert.Equal(t, 11, events[0].OldReviewerId)
+						assert.Equal(t, 13, events[0].NewReviewerId)
+						return nil
+					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputReassignDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "u4", out.ReplacedBy)
+				assert.Equal(t, []string{"u4", "u3"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "error GetOpenReviewCounts",
+			dto:  &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+					Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).
+					Return(&models.Team{TeamId: 5, ReviewerStrategy: "least_loaded", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 13, SystemId: "u4", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{13}).
+					Return(nil, errors.New("db"))
+			},
+			check: func(t *testing.T, out *models.OutputReassignDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrServerError, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.Reassign(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_UpdateTeamMembers(t *testing.T) {
+	tests := []struct {
+		name      string
+		dto       *models.UpdateTeamMembersDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error)
+	}{
+		{
+			name:      "unknown policy",
+			dto:       &models.UpdateTeamMembersDTO{TeamName: "backend", ReviewsPolicy: "drop"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrUnknownReviewsPolicy, err)
+			},
+		},
+		{
+			name: "team not found",
+			dto:  &models.UpdateTeamMembersDTO{TeamName: "backend"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "add new member and move existing one keeping reviews",
+			dto: &models.UpdateTeamMembersDTO{
+				TeamName: "backend",
+				Members: []models.MemberDTO{
+					{UserID: "u1", Username: "Nick", IsActive: true},
+					{UserID: "u9", Username: "Kate", IsActive: true},
+				},
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").
+					Return(&models.Team{TeamId: 1, TeamName: "backend"}, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(nil, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u9").
+					Return(&models.User{UserId: 9, SystemId: "u9", TeamId: 2}, nil)
+				m.EXPECT().UpdateTeamMembers(gomock.Any(), 1, gomock.Len(2), []int{}, gomock.Nil(), gomock.Len(0)).Return(nil)
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").
+					Return(&models.Team{
+						TeamId:   1,
+						TeamName: "backend",
+						TeamMembers: []*models.User{
+							{SystemId: "u1", UserName: "Nick", IsActive: true},
+							{SystemId: "u9", UserName: "Kate", IsActive: true},
+						},
+					}, nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, out.Team.Members, 2)
+				assert.Empty(t, out.Reassigned)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.UpdateTeamMembers(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_RemoveTeamMembers(t *testing.T) {
+	backend := func() *models.Team {
+		return &models.Team{
+			TeamId:   1,
+			TeamName: "backend",
+			TeamMembers: []*models.User{
+				{UserId: 10, SystemId: "u1", TeamId: 1, IsActive: true},
+				{UserId: 11, SystemId: "u2", TeamId: 1, IsActive: true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dto       *models.RemoveTeamMembersDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error)
+	}{
+		{
+			name: "user is not a member",
+			dto:  &models.RemoveTeamMembersDTO{TeamName: "backend", UserIds: []string{"u7"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend(), nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "remove and reassign open reviews inside the old team",
+			dto: &models.RemoveTeamMembersDTO{
+				TeamName:      "backend",
+				UserIds:       []string{"u2"},
+				ReviewsPolicy: models.ReviewsPolicyReassign,
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend(), nil)
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 11).
+					Return([]*models.PullRequest{
+						{SystemId: "PR1", Status: "OPEN"},
+						{SystemId: "PR2", Status: "MERGED"},
+						{SystemId: "PR3", Status: "OPEN"},
+					}, nil)
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{
+						PullRequestId:     1,
+						SystemId:          "PR1",
+						AuthorSystemId:    "u5",
+						AuthorTeamId:      1,
+						AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+					}, nil)
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR3").
+					Return(&models.PullRequest{
+						PullRequestId:     3,
+						SystemId:          "PR3",
+						AuthorSystemId:    "u1",
+						AuthorTeamId:      1,
+						AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+					}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 1).
+					Return(&models.Team{TeamId: 1, ReviewerStrategy: "random"}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 1).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+					}, nil)
+				m.EXPECT().UpdateTeamMembers(gomock.Any(), 1, gomock.Nil(), []int{11}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, _ []*models.User, _ []int, changes []*models.ReviewerChange, _ []*models.PrEvent) error {
+						assert.Len(t, changes, 2)
+						assert.Equal(t, "u1", changes[0].NewReviewer.SystemId)
+						assert.Nil(t, changes[1].NewReviewer)
+						return nil
+					})
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend(), nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []models.ReviewerChangeDTO{
+					{PullRequestId: "PR1", OldReviewerId: "u2", ReplacedBy: "u1"},
+					{PullRequestId: "PR3", OldReviewerId: "u2", ReplacedBy: "-"},
+				}, out.Reassigned)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.RemoveTeamMembers(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_RemovedAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(mockRepo)
+	ctx := context.Background()
+
+	backend := &models.Team{TeamId: 1, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}
+	backend.TeamMembers = []*models.User{
+		{UserId: 10, SystemId: "u1", TeamId: 1, IsActive: true},
+		{UserId: 11, SystemId: "u2", TeamId: 1, IsActive: true},
+		{UserId: 12, SystemId: "u3", TeamId: 1, IsActive: true},
+		{UserId: 13, SystemId: "u4", TeamId: 1, IsActive: true},
+	}
+
+	// the PR stays with the team its author had when it was opened
+	authoredPR := func(reviewers ...*models.User) *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorId:          10,
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      1,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: reviewers,
+		}
+	}
+
+	mockRepo.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend, nil).Times(2)
+	mockRepo.EXPECT().UpdateTeamMembers(gomock.Any(), 1, gomock.Nil(), []int{10}, gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := uc.RemoveTeamMembers(ctx, &models.RemoveTeamMembersDTO{TeamName: "backend", UserIds: []string{"u1"}})
+	assert.NoError(t, err)
+
+	t.Run("reassign", func(t *testing.T) {
+		mockRepo.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+			Return(authoredPR(&models.User{UserId: 11, SystemId: "u2"}, &models.User{UserId: 12, SystemId: "u3"}), nil)
+		mockRepo.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+			Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 1}, nil)
+		mockRepo.EXPECT().GetTeamById(gomock.Any(), 1).Return(backend, nil)
+		mockRepo.EXPECT().GetTeamMembers(gomock.Any(), 1).Return(backend.TeamMembers[1:], nil)
+		mockRepo.EXPECT().ReplaceReviewers(gomock.Any(), 1, 11, 13, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.Reassign(ctx, &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "u4", out.ReplacedBy)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		mockRepo.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+			Return(authoredPR(&models.User{UserId: 12, SystemId: "u3"}, &models.User{UserId: 13, SystemId: "u4"}), nil)
+		mockRepo.EXPECT().GetTeamById(gomock.Any(), 1).Return(backend, nil)
+		mockRepo.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Any()).
+			Return(sql.NullTime{Time: time.Now(), Valid: true}, nil)
+
+		out, err := uc.MergePullRequest(ctx, &models.InputMergePullRequestDTO{PullRequestId: "PR1"})
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusMerged, out.Status)
+		assert.Equal(t, "u1", out.AuthorID)
+	})
+}
+
+func TestUseCase_PullRequestWithoutTeam(t *testing.T) {
+	teamless := func() *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorSystemId:    "u1",
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}
+	}
+
+	t.Run("merge uses the default settings", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(teamless(), nil)
+		m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Len(1)).
+			Return(sql.NullTime{Time: time.Now(), Valid: true}, nil)
+
+		out, err := uc.MergePullRequest(context.Background(), &models.InputMergePullRequestDTO{PullRequestId: "PR1"})
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusMerged, out.Status)
+	})
+
+	t.Run("reassign to a named reviewer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(teamless(), nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2"}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u7").Return(&models.User{UserId: 7, SystemId: "u7", TeamId: 8}, nil)
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 7, gomock.Len(1)).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2", RequestedReviewerId: "u7"})
+		assert.NoError(t, err)
+		assert.Equal(t, "u7", out.ReplacedBy)
+	})
+
+	t.Run("reassign without candidates removes the reviewer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(teamless(), nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2"}, nil)
+		m.EXPECT().DeleteReview(gomock.Any(), 1, 2, gomock.Len(1)).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+		assert.NoError(t, err)
+		assert.Empty(t, out.AssignedReviewers)
+	})
+}
+
+func TestUseCase_MoveUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		dto       *models.MoveUserDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error)
+	}{
+		{
+			name: "user not found",
+			dto:  &models.MoveUserDTO{UserId: "u1", TeamName: "frontend"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "move with reassign policy",
+			dto:  &models.MoveUserDTO{UserId: "u2", TeamName: "frontend", ReviewsPolicy: models.ReviewsPolicyReassign},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+					Return(&models.User{UserId: 11, SystemId: "u2", UserName: "Sara", TeamId: 1, IsActive: true, ReviewWeight: 3}, nil)
+				m.EXPECT().GetTeamByName(gomock.Any(), "frontend").
+					Return(&models.Team{TeamId: 2, TeamName: "frontend"}, nil)
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 11).
+					Return([]*models.PullRequest{{SystemId: "PR1", Status: "OPEN"}}, nil)
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{
+						PullRequestId:  1,
+						SystemId:       "PR1",
+						AuthorSystemId: "u1",
+						AuthorTeamId:   1,
+						AssigneeReviewers: []*models.User{
+							{UserId: 11, SystemId: "u2"},
+							{UserId: 12, SystemId: "u3"},
+						},
+					}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 1).
+					Return(&models.Team{TeamId: 1, ReviewerStrategy: "random"}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 1).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+						{UserId: 12, SystemId: "u3", IsActive: true},
+						{UserId: 13, SystemId: "u4", IsActive: true},
+					}, nil)
+				m.EXPECT().UpdateTeamMembers(gomock.Any(), 2, gomock.Any(), []int{}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, upserts []*models.User, _ []int, changes []*models.ReviewerChange, _ []*models.PrEvent) error {
+						assert.Len(t, upserts, 1)
+						assert.Equal(t, "Sara", upserts[0].UserName)
+						assert.Equal(t, 3, upserts[0].ReviewWeight)
+						assert.Len(t, changes, 1)
+						assert.Equal(t, "u4", changes[0].NewReviewer.SystemId)
+						return nil
+					})
+				m.EXPECT().GetTeamByName(gomock.Any(), "frontend").
+					Return(&models.Team{TeamId: 2, TeamName: "frontend"}, nil)
+			},
+			check: func(t *testing.T, out *models.TeamMembersUpdatedDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []models.ReviewerChangeDTO{
+					{PullRequestId: "PR1", OldReviewerId: "u2", ReplacedBy: "u4"},
+				}, out.Reassigned)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.MoveUser(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_BulkDeactivate(t *testing.T) {
+	tests := []struct {
+		name      string
+		dto       *models.BulkDeactivateDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.BulkDeactivateResultDTO, err error)
+	}{
+		{
+			name:      "nothing to deactivate",
+			dto:       &models.BulkDeactivateDTO{},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.BulkDeactivateResultDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrParseData, err)
+			},
+		},
+		{
+			name: "user not found",
+			dto:  &models.BulkDeactivateDTO{UserIds: []string{"u1"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.BulkDeactivateResultDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "deactivate team and extra user",
+			dto:  &models.BulkDeactivateDTO{TeamName: "backend", UserIds: []string{"u1", "u7"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").
+					Return(&models.Team{
+						TeamId:   1,
+						TeamName: "backend",
+						TeamMembers: []*models.User{
+							{UserId: 10, SystemId: "u1", TeamId: 1, IsActive: true},
+							{UserId: 11, SystemId: "u2", TeamId: 1, IsActive: true},
+						},
+					}, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u7").
+					Return(&models.User{UserId: 17, SystemId: "u7", TeamId: 2, IsActive: true}, nil)
+
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 10).
+					Return([]*models.PullRequest{{SystemId: "PR1", Status: "OPEN"}}, nil)
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 11).
+					Return([]*models.PullRequest{
+						{SystemId: "PR1", Status: "OPEN"},
+						{SystemId: "PR2", Status: "OPEN"},
+					}, nil)
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 17).Return([]*models.PullRequest{}, nil)
+
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{
+						PullRequestId:  1,
+						SystemId:       "PR1",
+						AuthorSystemId: "u5",
+						AuthorTeamId:   1,
+						AssigneeReviewers: []*models.User{
+							{UserId: 10, SystemId: "u1"},
+							{UserId: 11, SystemId: "u2"},
+						},
+					}, nil)
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR2").
+					Return(&models.PullRequest{
+						PullRequestId:     2,
+						SystemId:          "PR2",
+						AuthorSystemId:    "u3",
+						AuthorTeamId:      1,
+						AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+					}, nil)
+
+				m.EXPECT().GetTeamById(gomock.Any(), 1).
+					Return(&models.Team{TeamId: 1, ReviewerStrategy: "random"}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 1).
+					Return([]*models.User{
+						{UserId: 10, SystemId: "u1", IsActive: true},
+						{UserId: 11, SystemId: "u2", IsActive: true},
+						{UserId: 13, SystemId: "u3", IsActive: true},
+					}, nil)
+
+				m.EXPECT().DeactivateUsers(gomock.Any(), []int{10, 11, 17}, gomock.Len(3), gomock.Len(3)).Return(nil)
+			},
+			check: func(t *testing.T, out *models.BulkDeactivateResultDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u1", "u2", "u7"}, out.DeactivatedUsers)
+				assert.Equal(t, []models.ReviewerChangeDTO{
+					{PullRequestId: "PR1", OldReviewerId: "u1", ReplacedBy: "u3"},
+				}, out.Reassigned)
+				assert.Equal(t, []models.ReviewerChangeDTO{
+					{PullRequestId: "PR1", OldReviewerId: "u2", ReplacedBy: "-"},
+					{PullRequestId: "PR2", OldReviewerId: "u2", ReplacedBy: "-"},
+				}, out.Dropped)
+				assert.Equal(t, []string{"PR2"}, out.WithoutReviewers)
+			},
+		},
+		{
+			name: "error DeactivateUsers",
+			dto:  &models.BulkDeactivateDTO{UserIds: []string{"u7"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u7").
+					Return(&models.User{UserId: 17, SystemId: "u7", TeamId: 2}, nil)
+				m.EXPECT().GetListReviewsByUserId(gomock.Any(), 17).Return([]*models.PullRequest{}, nil)
+				m.EXPECT().DeactivateUsers(gomock.Any(), []int{17}, gomock.Any(), gomock.Any()).Return(errors.New("db"))
+			},
+			check: func(t *testing.T, out *models.BulkDeactivateResultDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrServerError, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.BulkDeactivate(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_CreatePullRequestEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(m)
+
+	m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+	m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+		Return(&models.User{UserId: 10, TeamId: 9, SystemId: "u1"}, nil)
+	m.EXPECT().GetTeamById(gomock.Any(), 9).
+		Return(&models.Team{TeamId: 9, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+	m.EXPECT().GetTeamMembers(gomock.Any(), 9).
+		Return([]*models.User{
+			{UserId: 10, SystemId: "u1", IsActive: true},
+			{UserId: 11, SystemId: "u2", IsActive: true},
+		}, nil)
+	m.EXPECT().GetPartnerTeams(gomock.Any(), 9).Return(nil, nil)
+	m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *models.PullRequest, _ []*models.User, events []*models.PrEvent) error {
+			assert.Len(t, events, 2)
+			assert.Equal(t, models.EventPullRequestCreated, events[0].EventType)
+			assert.Equal(t, "ci-bot", events[0].Actor)
+			assert.Equal(t, models.EventReviewerAssigned, events[1].EventType)
+			assert.Equal(t, 11, events[1].NewReviewerId)
+			assert.Equal(t, "assigned by random strategy", events[1].Reason)
+			return nil
+		})
+	m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+	ctx := actor.WithActor(context.Background(), "ci-bot")
+	_, err := uc.CreatePullRequest(ctx, &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+	assert.NoError(t, err)
+}
+
+func TestUseCase_GetPullRequestHistory(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		prId      string
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.PullRequestHistoryDTO, err error)
+	}{
+		{
+			name: "PR not found",
+			prId: "PR1",
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.PullRequestHistoryDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "error GetPullRequestEvents",
+			prId: "PR1",
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{PullRequestId: 1, SystemId: "PR1"}, nil)
+				m.EXPECT().GetPullRequestEvents(gomock.Any(), 1).Return(nil, errors.New("db"))
+			},
+			check: func(t *testing.T, out *models.PullRequestHistoryDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrServerError, err)
+			},
+		},
+		{
+			name: "timeline",
+			prId: "PR1",
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+					Return(&models.PullRequest{PullRequestId: 1, SystemId: "PR1"}, nil)
+				m.EXPECT().GetPullRequestEvents(gomock.Any(), 1).
+					Return([]*models.PrEvent{
+						{EventType: models.EventReviewerAssigned, NewReviewerSystemId: "u2", Reason: "assigned by random strategy", CreatedAt: created},
+						{EventType: models.EventReviewerReplaced, Actor: "lead", OldReviewerSystemId: "u2", NewReviewerSystemId: "u3", Reason: "reassign requested", CreatedAt: created},
+					}, nil)
+			},
+			check: func(t *testing.T, out *models.PullRequestHistoryDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &models.PullRequestHistoryDTO{
+					PullRequestId: "PR1",
+					Events: []models.PullRequestEventDTO{
+						{EventType: "REVIEWER_ASSIGNED", NewReviewerId: "u2", Reason: "assigned by random strategy", CreatedAt: "2025-01-02T03:04:05Z"},
+						{EventType: "REVIEWER_REPLACED", Actor: "lead", OldReviewerId: "u2", NewReviewerId: "u3", Reason: "reassign requested", CreatedAt: "2025-01-02T03:04:05Z"},
+					},
+				}, out)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.GetPullRequestHistory(context.Background(), tt.prId)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_GetAssignmentStats(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		dto       *models.InputAssignmentStatsDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.AssignmentStatsDTO, err error)
+	}{
+		{
+			name:      "invalid from",
+			dto:       &models.InputAssignmentStatsDTO{From: "yesterday"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.AssignmentStatsDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrParseData, err)
+			},
+		},
+		{
+			name:      "empty window",
+			dto:       &models.InputAssignmentStatsDTO{From: "2025-02-01T00:00:00Z", To: "2025-01-01T00:00:00Z"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.AssignmentStatsDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrParseData, err)
+			},
+		},
+		{
+			name: "error GetReviewerStats",
+			dto:  &models.InputAssignmentStatsDTO{},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetReviewerStats(gomock.Any(), sql.NullTime{}, sql.NullTime{}, "").
+					Return(nil, errors.New("db"))
+			},
+			check: func(t *testing.T, out *models.AssignmentStatsDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrServerError, err)
+			},
+		},
+		{
+			name: "aggregated per team",
+			dto:  &models.InputAssignmentStatsDTO{From: "2025-01-01T00:00:00Z", To: "2025-02-01T00:00:00Z"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetReviewerStats(gomock.Any(),
+					sql.NullTime{Time: from, Valid: true},
+					sql.NullTime{Time: to, Valid: true}, "").
+					Return([]*models.ReviewerStats{
+						{UserSystemId: "u0", UserName: "Gone", Assigned: 1, Merged: 1},
+						{UserSystemId: "u1", UserName: "Alice", TeamName: "backend", Assigned: 3, Open: 1, Merged: 2},
+						{UserSystemId: "u2", UserName: "Bob", TeamName: "backend", Assigned: 2, Open: 2},
+						{UserSystemId: "u3", UserName: "Carol", TeamName: "frontend"},
+					}, nil)
+			},
+			check: func(t *testing.T, out *models.AssignmentStatsDTO, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, out.Users, 4)
+				assert.Equal(t, []models.TeamAssignmentStatsDTO{
+					{TeamName: "backend", Members: 2, Assigned: 5, Open: 3, Merged: 2},
+					{TeamName: "frontend", Members: 1},
+				}, out.Teams)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.GetAssignmentStats(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_PullRequestLifecycle(t *testing.T) {
+	draft := func() *models.PullRequest {
+		return &models.PullRequest{PullRequestId: 1, SystemId: "PR1", AuthorSystemId: "u1", AuthorTeamId: 5, Status: models.StatusDraft}
+	}
+	team := &models.Team{TeamId: 5, ReviewerStrategy: "round_robin", MinReviewers: 1, MaxReviewers: 2}
+
+	tests := []struct {
+		name      string
+		call      func(uc *usecase.UseCase) (any, error)
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out any, err error)
+	}{
+		{
+			name: "create draft assigns nobody",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1", Draft: true})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(&models.User{UserId: 1, SystemId: "u1", TeamId: 5}, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{{UserId: 2, SystemId: "u2", IsActive: true}}, nil)
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), []*models.User{}, gomock.Len(1)).Return(nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.NoError(t, err)
+				pr := out.(*models.OutputCreatePullRequestDTO)
+				assert.Equal(t, models.StatusDraft, pr.Status)
+				assert.Empty(t, pr.AssignedReviewers)
+			},
+		},
+		{
+			name: "mark ready assigns reviewers",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.MarkReadyPullRequest(context.Background(), &models.InputChangeStatusPullRequestDTO{PullRequestId: "PR1"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(draft(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+					{UserId: 1, SystemId: "u1", IsActive: true},
+					{UserId: 2, SystemId: "u2", IsActive: true},
+					{UserId: 3, SystemId: "u3", IsActive: true},
+				}, nil)
+				m.EXPECT().SetPullRequestStatus(gomock.Any(), 1, models.StatusOpen, gomock.Len(2), gomock.Len(3)).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.NoError(t, err)
+				pr := out.(*models.OutputChangeStatusPullRequestDTO)
+				assert.Equal(t, models.StatusOpen, pr.Status)
+				assert.Equal(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+			},
+		},
+		{
+			name: "close draft",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.ClosePullRequest(context.Background(), &models.InputChangeStatusPullRequestDTO{PullRequestId: "PR1"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(draft(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().SetPullRequestStatus(gomock.Any(), 1, models.StatusClosed, []*models.User{}, gomock.Len(1)).Return(nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, models.StatusClosed, out.(*models.OutputChangeStatusPullRequestDTO).Status)
+			},
+		},
+		{
+			name: "reopen keeps reviewers",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.ReopenPullRequest(context.Background(), &models.InputChangeStatusPullRequestDTO{PullRequestId: "PR1"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := draft()
+				pr.Status = models.StatusClosed
+				pr.AssigneeReviewers = []*models.User{{UserId: 2, SystemId: "u2"}}
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().SetPullRequestStatus(gomock.Any(), 1, models.StatusOpen, []*models.User{}, gomock.Len(1)).Return(nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u2"}, out.(*models.OutputChangeStatusPullRequestDTO).AssignedReviewers)
+			},
+		},
+		{
+			name: "reopen draft is rejected",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.ReopenPullRequest(context.Background(), &models.InputChangeStatusPullRequestDTO{PullRequestId: "PR1"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(draft(), nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.ErrorIs(t, err, appErrors.ErrInvalidStatusTransition)
+				var transitionErr *appErrors.StatusTransitionError
+				assert.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, models.StatusDraft, transitionErr.From)
+				assert.Equal(t, models.StatusOpen, transitionErr.To)
+			},
+		},
+		{
+			name: "merge closed is rejected",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.MergePullRequest(context.Background(), &models.InputMergePullRequestDTO{PullRequestId: "PR1"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := draft()
+				pr.Status = models.StatusClosed
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.ErrorIs(t, err, appErrors.ErrInvalidStatusTransition)
+			},
+		},
+		{
+			name: "reassign on closed is rejected",
+			call: func(uc *usecase.UseCase) (any, error) {
+				return uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := draft()
+				pr.Status = models.StatusClosed
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5}, nil)
+			},
+			check: func(t *testing.T, out any, err error) {
+				assert.Equal(t, appErrors.ErrPullRequestClosed, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := tt.call(uc)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_SubmitReview(t *testing.T) {
+	decided := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	openPR := func() *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dto       *models.InputSubmitReviewDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.OutputSubmitReviewDTO, err error)
+	}{
+		{
+			name:      "unknown decision",
+			dto:       &models.InputSubmitReviewDTO{PullRequestId: "PR1", ReviewerId: "u2", Decision: "LGTM"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.OutputSubmitReviewDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrUnknownDecision, err)
+			},
+		},
+		{
+			name: "reviewer not assigned",
+			dto:  &models.InputSubmitReviewDTO{PullRequestId: "PR1", ReviewerId: "u3", Decision: "APPROVED"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+			},
+			check: func(t *testing.T, out *models.OutputSubmitReviewDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrReviewerNotAssigned, err)
+			},
+		},
+		{
+			name: "merged PR",
+			dto:  &models.InputSubmitReviewDTO{PullRequestId: "PR1", ReviewerId: "u2", Decision: "APPROVED"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := openPR()
+				pr.Status = models.StatusMerged
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+			},
+			check: func(t *testing.T, out *models.OutputSubmitReviewDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrPullRequestMerged, err)
+			},
+		},
+		{
+			name: "approved",
+			dto:  &models.InputSubmitReviewDTO{PullRequestId: "PR1", ReviewerId: "u2", Decision: "APPROVED"},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().SetReviewDecision(gomock.Any(), 1, 2, "APPROVED", gomock.Len(1)).
+					Return(sql.NullTime{Time: decided, Valid: true}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputSubmitReviewDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &models.OutputSubmitReviewDTO{
+					PullRequestId: "PR1",
+					ReviewerId:    "u2",
+					Decision:      "APPROVED",
+					DecidedAt:     "2025-01-02T03:04:05Z",
+				}, out)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.SubmitReview(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_MergePolicy(t *testing.T) {
+	decided := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	pr := func() *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}, {UserId: 3, SystemId: "u3"}},
+			Decisions: map[int]*models.ReviewDecision{
+				2: {Decision: models.DecisionApproved, DecidedAt: decided},
+				3: {Decision: models.DecisionChangesRequested, DecidedAt: decided},
+			},
+		}
+	}
+	strict := models.MergePolicy{RequireReviewer: true, NoChangesRequested: true, NoSelfApproval: true}
+	admin := actor.WithAdmin(actor.WithActor(context.Background(), "admin"), true)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		force     bool
+		team      *models.Team
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.OutputMergePullRequestDTO, err error)
+	}{
+		{
+			name:      "not enough approvals",
+			ctx:       context.Background(),
+			team:      &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 2},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, appErrors.ErrMergePolicyViolated)
+
+				var policyErr *appErrors.MergePolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				assert.Equal(t, []appErrors.PolicyViolation{
+					{Rule: "required_approvals", Message: "PR has 1 of 2 required approvals"},
+				}, policyErr.Violations)
+			},
+		},
+		{
+			name:      "every failed rule is listed",
+			ctx:       context.Background(),
+			team:      &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 2, MergePolicy: strict},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				var policyErr *appErrors.MergePolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				assert.Equal(t, []appErrors.PolicyViolation{
+					{Rule: "required_approvals", Message: "PR has 1 of 2 required approvals"},
+					{Rule: "no_changes_requested", Message: "changes requested by u3"},
+				}, policyErr.Violations)
+			},
+		},
+		{
+			name: "approvals present",
+			ctx:  context.Background(),
+			team: &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 1},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Len(1)).
+					Return(sql.NullTime{Time: decided, Valid: true}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, out.Approvals)
+				assert.False(t, out.Forced)
+				assert.Equal(t, []models.ReviewerDecisionDTO{
+					{ReviewerId: "u2", Decision: "APPROVED", DecidedAt: "2025-01-02T03:04:05Z"},
+					{ReviewerId: "u3", Decision: "CHANGES_REQUESTED", DecidedAt: "2025-01-02T03:04:05Z"},
+				}, out.Reviews)
+			},
+		},
+		{
+			name:      "only approver merges",
+			ctx:       actor.WithActor(context.Background(), "u2"),
+			team:      &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 1, MergePolicy: models.MergePolicy{NoSelfApproval: true}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				var policyErr *appErrors.MergePolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				assert.Equal(t, []appErrors.PolicyViolation{
+					{Rule: "no_self_approval", Message: "u2 is the only approver and cannot merge"},
+				}, policyErr.Violations)
+			},
+		},
+		{
+			name: "someone else merges",
+			ctx:  actor.WithActor(context.Background(), "u1"),
+			team: &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 1, MergePolicy: models.MergePolicy{NoSelfApproval: true}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Len(1)).
+					Return(sql.NullTime{Time: decided, Valid: true}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:      "force by non admin",
+			ctx:       actor.WithActor(context.Background(), "u1"),
+			force:     true,
+			team:      &models.Team{TeamId: 5, MaxReviewers: 2},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrForbidden, err)
+			},
+		},
+		{
+			name:  "force by admin bypasses the policy",
+			ctx:   admin,
+			force: true,
+			team:  &models.Team{TeamId: 5, MaxReviewers: 2, RequiredApprovals: 2, MergePolicy: strict},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, []*models.PrEvent{{
+					PullRequestId: 1,
+					EventType:     models.EventPullRequestMerged,
+					Actor:         "admin",
+					Reason:        "pull request force merged, bypassed: required_approvals, no_changes_requested",
+				}}).Return(sql.NullTime{Time: decided, Valid: true}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputMergePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.True(t, out.Forced)
+				assert.Equal(t, []string{"required_approvals", "no_changes_requested"}, out.BypassedRules)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			mockRepo.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr(), nil)
+			mockRepo.EXPECT().GetTeamById(gomock.Any(), 5).Return(tt.team, nil)
+			tt.mockSetup(mockRepo)
+
+			out, err := uc.MergePullRequest(tt.ctx, &models.InputMergePullRequestDTO{PullRequestId: "PR1", Force: tt.force})
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_ReviewersCountAndApprovals(t *testing.T) {
+	backend := &models.Team{TeamId: 5, TeamName: "backend", MinReviewers: 1, MaxReviewers: 3, RequiredApprovals: 2}
+
+	tests := []struct {
+		name      string
+		call      func(uc *usecase.UseCase) (*models.TeamDTO, error)
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		err       error
+	}{
+		{
+			name: "max reviewers below required approvals",
+			call: func(uc *usecase.UseCase) (*models.TeamDTO, error) {
+				return uc.SetReviewersCount(context.Background(), &models.SetReviewersCountDTO{TeamName: "backend", MinReviewers: 1, MaxReviewers: 1})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend, nil)
+			},
+			err: appErrors.ErrInvalidReviewersCount,
+		},
+		{
+			name: "max reviewers equal to required approvals",
+			call: func(uc *usecase.UseCase) (*models.TeamDTO, error) {
+				return uc.SetReviewersCount(context.Background(), &models.SetReviewersCountDTO{TeamName: "backend", MinReviewers: 1, MaxReviewers: 2})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend, nil).Times(2)
+				m.EXPECT().SetTeamReviewersCount(gomock.Any(), "backend", 1, 2).Return(true, nil)
+			},
+		},
+		{
+			name: "required approvals above max reviewers",
+			call: func(uc *usecase.UseCase) (*models.TeamDTO, error) {
+				return uc.SetRequiredApprovals(context.Background(), &models.SetRequiredApprovalsDTO{TeamName: "backend", RequiredApprovals: 4})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend, nil)
+			},
+			err: appErrors.ErrInvalidRequiredApprovals,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(m)
+			tt.mockSetup(m)
+
+			out, err := tt.call(uc)
+			if tt.err != nil {
+				assert.Nil(t, out)
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCase_ChangeReviewers(t *testing.T) {
+	openPR := func() *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}
+	}
+	team := &models.Team{TeamId: 5, MinReviewers: 1, MaxReviewers: 2}
+
+	tests := []struct {
+		name      string
+		call      func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error)
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.OutputChangeReviewersDTO, err error)
+	}{
+		{
+			name: "add to merged PR",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.AddReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u3"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				pr := openPR()
+				pr.Status = models.StatusMerged
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(pr, nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrPullRequestMerged, err)
+			},
+		},
+		{
+			name: "add unknown user",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.AddReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u9"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u9").Return(nil, nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrResourceNotFound, err)
+			},
+		},
+		{
+			name: "add author",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.AddReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u1"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(&models.User{UserId: 1, SystemId: "u1"}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrReviewerIsAuthor, err)
+			},
+		},
+		{
+			name: "add already assigned",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.AddReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u2"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2"}, nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrReviewerAlreadyAssigned, err)
+			},
+		},
+		{
+			name: "add reviewer",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.AddReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u3"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				u3 := &models.User{UserId: 3, SystemId: "u3"}
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().GetUserBySystemId(gomock.Any(), "u3").Return(u3, nil)
+				m.EXPECT().UpdateReviewers(gomock.Any(), 1, []*models.User{u3}, nil, gomock.Len(1)).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u2", "u3"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "remove not assigned",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.RemoveReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u3"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrReviewerNotAssigned, err)
+			},
+		},
+		{
+			name: "remove reviewer",
+			call: func(uc *usecase.UseCase) (*models.OutputChangeReviewersDTO, error) {
+				return uc.RemoveReviewers(context.Background(), &models.InputChangeReviewersDTO{PullRequestId: "PR1", ReviewerIds: []string{"u2"}})
+			},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(openPR(), nil)
+				m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+				m.EXPECT().UpdateReviewers(gomock.Any(), 1, nil, []*models.User{{UserId: 2, SystemId: "u2"}}, gomock.Len(1)).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputChangeReviewersDTO, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, out.AssignedReviewers)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			out, err := tt.call(uc)
+			tt.check(t, out, err)
+		})
+	}
+}
+
+func TestUseCase_ReassignRequestedReviewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(mockRepo)
+
+	mockRepo.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+		PullRequestId:     1,
+		SystemId:          "PR1",
+		AuthorSystemId:    "u1",
+		AuthorTeamId:      5,
+		Status:            models.StatusOpen,
+		AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+	}, nil)
+	mockRepo.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5}, nil)
+	mockRepo.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, MaxReviewers: 2}, nil)
+	mockRepo.EXPECT().GetUserBySystemId(gomock.Any(), "u7").Return(&models.User{UserId: 7, SystemId: "u7", TeamId: 8}, nil)
+	mockRepo.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 7, gomock.Len(1)).Return(nil)
+	mockRepo.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+	out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2", RequestedReviewerId: "u7"})
+	assert.NoError(t, err)
+	assert.Equal(t, "u7", out.ReplacedBy)
+	assert.Equal(t, []string{"u7"}, out.AssignedReviewers)
+}
+
+func TestUseCase_PartnerPools(t *testing.T) {
+	t.Run("partner fills missing reviewers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 10, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+			Return([]*models.User{
+				{UserId: 10, SystemId: "u1", IsActive: true},
+				{UserId: 11, SystemId: "u2", IsActive: true},
+			}, nil)
+		m.EXPECT().GetPartnerTeams(gomock.Any(), 5).
+			Return([]*models.Team{
+				{TeamId: 6, TeamName: "empty", ReviewerStrategy: "random"},
+				{TeamId: 7, TeamName: "frontend", ReviewerStrategy: "random"},
+			}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 6).
+			Return([]*models.User{{UserId: 20, SystemId: "u20"}}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 7).
+			Return([]*models.User{{UserId: 30, SystemId: "u30", IsActive: true}}, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *models.PullRequest, reviewers []*models.User, events []*models.PrEvent) error {
+				assert.Len(t, reviewers, 2)
+				assert.Equal(t, "assigned by random strategy", events[1].Reason)
+				assert.Equal(t, "assigned from partner team frontend", events[2].Reason)
+				return nil
+			})
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u2", "u30"}, out.AssignedReviewers)
+		assert.Equal(t, []models.ReviewerPoolDTO{
+			{ReviewerId: "u2", Pool: models.PoolHome, TeamName: "backend"},
+			{ReviewerId: "u30", Pool: models.PoolPartner, TeamName: "frontend"},
+		}, out.ReviewerPools)
+	})
+
+	t.Run("home team is enough", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 10, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 1}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+			Return([]*models.User{{UserId: 11, SystemId: "u2", IsActive: true}}, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []models.ReviewerPoolDTO{{ReviewerId: "u2", Pool: models.PoolHome, TeamName: "backend"}}, out.ReviewerPools)
+	})
+
+	t.Run("team cannot partner itself", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(&models.Team{TeamId: 5, TeamName: "backend"}, nil)
+
+		out, err := uc.SetPartnerTeams(context.Background(), &models.SetPartnerTeamsDTO{TeamName: "backend", PartnerTeams: []string{"backend"}})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrInvalidPartnerTeam, err)
+	})
+
+	t.Run("set partners in order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		backend := &models.Team{TeamId: 5, TeamName: "backend", PartnerTeams: []string{"frontend", "mobile"}}
+		m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(backend, nil).Times(2)
+		m.EXPECT().GetTeamByName(gomock.Any(), "frontend").Return(&models.Team{TeamId: 7, TeamName: "frontend"}, nil)
+		m.EXPECT().GetTeamByName(gomock.Any(), "mobile").Return(&models.Team{TeamId: 8, TeamName: "mobile"}, nil)
+		m.EXPECT().SetTeamPartners(gomock.Any(), 5, []int{7, 8}).Return(nil)
+
+		out, err := uc.SetPartnerTeams(context.Background(), &models.SetPartnerTeamsDTO{TeamName: "backend", PartnerTeams: []string{"frontend", "mobile", "frontend"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"frontend", "mobile"}, out.PartnerTeams)
+	})
+}
+
+func TestUseCase_ReassignPool(t *testing.T) {
+	tests := []struct {
+		name      string
+		pool      string
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		replaced  string
+	}{
+		{
+			name: "author team",
+			pool: models.ReassignPoolAuthorTeam,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 1, SystemId: "u1", IsActive: true}, {UserId: 3, SystemId: "u3", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{3}).Return(map[int]int{}, nil)
+				m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 3, gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			replaced: "u3",
+		},
+		{
+			name: "default is author team",
+			pool: "",
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 3, SystemId: "u3", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{3}).Return(map[int]int{}, nil)
+				m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 3, gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			replaced: "u3",
+		},
+		{
+			name: "reviewer team",
+			pool: models.ReassignPoolReviewerTeam,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamById(gomock.Any(), 8).
+					Return(&models.Team{TeamId: 8, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 8).
+					Return([]*models.User{{UserId: 2, SystemId: "u2", IsActive: true}, {UserId: 4, SystemId: "u4", IsActive: true}}, nil)
+				m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 4, gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			replaced: "u4",
+		},
+		{
+			name: "union picks with author team strategy",
+			pool: models.ReassignPoolUnion,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamById(gomock.Any(), 8).
+					Return(&models.Team{TeamId: 8, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 3, SystemId: "u3", IsActive: true}}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 8).
+					Return([]*models.User{{UserId: 2, SystemId: "u2", IsActive: true}, {UserId: 4, SystemId: "u4", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), gomock.Any()).
+					Return(map[int]int{3: 4, 4: 1}, nil)
+				m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 4, gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			replaced: "u4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(m)
+
+			m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+				PullRequestId:     1,
+				SystemId:          "PR1",
+				AuthorSystemId:    "u1",
+				AuthorTeamId:      5,
+				Status:            models.StatusOpen,
+				AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2", TeamId: 8}},
+			}, nil)
+			m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+				Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 8}, nil)
+			m.EXPECT().GetTeamById(gomock.Any(), 5).
+				Return(&models.Team{TeamId: 5, ReviewerStrategy: "least_loaded", MaxReviewers: 2, ReassignPool: tt.pool}, nil)
+			tt.mockSetup(m)
+
+			out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.replaced, out.ReplacedBy)
+		})
+	}
+
+	t.Run("unknown pool", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := usecase.NewUseCase(mocks.NewMockRepositoryInterface(ctrl))
+
+		out, err := uc.SetReassignPool(context.Background(), &models.SetReassignPoolDTO{TeamName: "backend", ReassignPool: "anyone"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrUnknownReassignPool, err)
+	})
+}
+
+func TestUseCase_ReassignPoolOnDeactivation(t *testing.T) {
+	tests := []struct {
+		name      string
+		pool      string
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		replaced  string
+	}{
+		{
+			name: "author team",
+			pool: models.ReassignPoolAuthorTeam,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 1, SystemId: "u1", IsActive: true}, {UserId: 3, SystemId: "u3", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{3}).Return(map[int]int{}, nil)
+			},
+			replaced: "u3",
+		},
+		{
+			name: "reviewer team",
+			pool: models.ReassignPoolReviewerTeam,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamById(gomock.Any(), 8).
+					Return(&models.Team{TeamId: 8, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 8).
+					Return([]*models.User{{UserId: 2, SystemId: "u2", IsActive: true}, {UserId: 4, SystemId: "u4", IsActive: true}}, nil)
+			},
+			replaced: "u4",
+		},
+		{
+			name: "union picks with author team strategy",
+			pool: models.ReassignPoolUnion,
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				m.EXPECT().GetTeamById(gomock.Any(), 8).
+					Return(&models.Team{TeamId: 8, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+					Return([]*models.User{{UserId: 3, SystemId: "u3", IsActive: true}}, nil)
+				m.EXPECT().GetTeamMembers(gomock.Any(), 8).
+					Return([]*models.User{{UserId: 2, SystemId: "u2", IsActive: true}, {UserId: 4, SystemId: "u4", IsActive: true}}, nil)
+				m.EXPECT().GetOpenReviewCounts(gomock.Any(), gomock.Any()).
+					Return(map[int]int{3: 4, 4: 1}, nil)
+			},
+			replaced: "u4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(m)
+
+			m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+				Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 8, IsActive: true}, nil)
+			m.EXPECT().GetListReviewsByUserId(gomock.Any(), 2).
+				Return([]*models.PullRequest{{SystemId: "PR1", Status: models.StatusOpen}}, nil)
+			m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+				PullRequestId:     1,
+				SystemId:          "PR1",
+				AuthorSystemId:    "u1",
+				AuthorTeamId:      5,
+				Status:            models.StatusOpen,
+				AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2", TeamId: 8}},
+			}, nil)
+			m.EXPECT().GetTeamById(gomock.Any(), 5).
+				Return(&models.Team{TeamId: 5, ReviewerStrategy: "least_loaded", MaxReviewers: 2, ReassignPool: tt.pool}, nil)
+			tt.mockSetup(m)
+			m.EXPECT().DeactivateUsers(gomock.Any(), []int{2}, gomock.Any(), gomock.Len(1)).
+				DoAndReturn(func(_ context.Context, _ []int, changes []*models.ReviewerChange, _ []*models.PrEvent) error {
+					assert.Len(t, changes, 1)
+					assert.Equal(t, tt.replaced, changes[0].NewReviewer.SystemId)
+					return nil
+				})
+
+			out, err := uc.BulkDeactivate(context.Background(), &models.BulkDeactivateDTO{UserIds: []string{"u2"}})
+			assert.NoError(t, err)
+			assert.Equal(t, []models.ReviewerChangeDTO{
+				{PullRequestId: "PR1", OldReviewerId: "u2", ReplacedBy: tt.replaced},
+			}, out.Reassigned)
+		})
+	}
+
+	t.Run("reviewer team pool of a user without a team is empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+			Return(&models.User{UserId: 2, SystemId: "u2", IsActive: true}, nil)
+		m.EXPECT().GetListReviewsByUserId(gomock.Any(), 2).
+			Return([]*models.PullRequest{{SystemId: "PR1", Status: models.StatusOpen}}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", MaxReviewers: 2, ReassignPool: models.ReassignPoolReviewerTeam}, nil)
+		m.EXPECT().DeactivateUsers(gomock.Any(), []int{2}, gomock.Len(1), gomock.Len(1)).Return(nil)
+
+		out, err := uc.BulkDeactivate(context.Background(), &models.BulkDeactivateDTO{UserIds: []string{"u2"}})
+		assert.NoError(t, err)
+		assert.Empty(t, out.Reassigned)
+		assert.Equal(t, []string{"PR1"}, out.WithoutReviewers)
+	})
+}
+
+func TestUseCase_OwnershipRules(t *testing.T) {
+	t.Run("owners of changed files are picked first", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).
+			Return([]*models.User{
+				{UserId: 1, SystemId: "u1", IsActive: true},
+				{UserId: 3, SystemId: "u3", IsActive: true},
+			}, nil)
+		m.EXPECT().GetOwnershipRules(gomock.Any(), 5).
+			Return([]*models.OwnershipRule{
+				{RuleId: 1, Pattern: "*", Owners: []*models.User{{UserId: 3, SystemId: "u3", IsActive: true}}},
+				{RuleId: 2, Pattern: "/docs/", Owners: []*models.User{{UserId: 8, SystemId: "u8"}}},
+				{RuleId: 3, Pattern: "*.go", Owners: []*models.User{{UserId: 1, SystemId: "u1", IsActive: true}, {UserId: 7, SystemId: "u7", IsActive: true}}},
+			}, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, pr *models.PullRequest, reviewers []*models.User, events []*models.PrEvent) error {
+				assert.Equal(t, []string{"internal/app.go", "docs/intro.md"}, pr.ChangedFiles)
+				assert.Equal(t, "assigned as owner of *.go", events[1].Reason)
+				assert.Equal(t, "assigned by random strategy", events[2].Reason)
+				return nil
+			})
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *models.AssignmentExplanation) error {
+				reasons := make(map[string]string, len(e.Candidates))
+				for _, c := range e.Candidates {
+					reasons[c.SystemId] = c.ExcludedReason
+				}
+				assert.Equal(t, map[string]string{
+					"u1": models.ExcludedAuthor,
+					"u7": "",
+					"u8": models.ExcludedInactive,
+					"u3": "",
+				}, reasons)
+				return nil
+			})
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{
+			PullRequestId: "PR1",
+			AuthorId:      "u1",
+			ChangedFiles:  []string{"internal/app.go", " ", "docs/intro.md"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u7", "u3"}, out.AssignedReviewers)
+		assert.Equal(t, []models.ReviewerPoolDTO{
+			{ReviewerId: "u7", Pool: models.PoolOwner, MatchedRule: "*.go"},
+			{ReviewerId: "u3", Pool: models.PoolHome, TeamName: "backend"},
+		}, out.ReviewerPools)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := usecase.NewUseCase(mocks.NewMockRepositoryInterface(ctrl))
+
+		out, err := uc.AddOwnershipRule(context.Background(), &models.OwnershipRuleDTO{TeamName: "backend", Pattern: "!*.go"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrInvalidOwnershipPattern, err)
+	})
+
+	t.Run("add rule with unknown owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(&models.Team{TeamId: 5, TeamName: "backend"}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u9").Return(nil, nil)
+
+		out, err := uc.AddOwnershipRule(context.Background(), &models.OwnershipRuleDTO{TeamName: "backend", Pattern: "*.go", Owners: []string{"u9"}})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+
+	t.Run("add rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(&models.Team{TeamId: 5, TeamName: "backend"}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 2, SystemId: "u2"}, nil)
+		m.EXPECT().CreateOwnershipRule(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, rule *models.OwnershipRule) error {
+				assert.Equal(t, 5, rule.TeamId)
+				rule.RuleId = 4
+				return nil
+			})
+
+		out, err := uc.AddOwnershipRule(context.Background(), &models.OwnershipRuleDTO{TeamName: "backend", Pattern: "/app/", Owners: []string{"u2"}})
+		assert.NoError(t, err)
+		assert.Equal(t, &models.OwnershipRuleDTO{RuleId: 4, TeamName: "backend", Pattern: "/app/", Owners: []string{"u2"}}, out)
+	})
+
+	t.Run("update missing rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetOwnershipRuleById(gomock.Any(), 4).Return(nil, nil)
+
+		out, err := uc.UpdateOwnershipRule(context.Background(), &models.OwnershipRuleDTO{RuleId: 4, Pattern: "*.go"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+
+	t.Run("delete missing rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().DeleteOwnershipRule(gomock.Any(), 4).Return(false, nil)
+
+		err := uc.DeleteOwnershipRule(context.Background(), &models.DeleteOwnershipRuleDTO{RuleId: 4})
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+}
+
+func TestUseCase_SkillTags(t *testing.T) {
+	createSetup := func(m *mocks.MockRepositoryInterface, strategy string, members []*models.User) {
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: strategy, MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return(members, nil)
+	}
+
+	tests := []struct {
+		name      string
+		dto       *models.InputCreatePullRequestDTO
+		mockSetup func(m *mocks.MockRepositoryInterface)
+		check     func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error)
+	}{
+		{
+			name: "skilled teammate preferred",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1", ReviewersCount: intPtr(1), RequiredTags: []string{" Go", "go"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				createSetup(m, "random", []*models.User{
+					{UserId: 2, SystemId: "u2", IsActive: true, Tags: []string{"frontend"}},
+					{UserId: 3, SystemId: "u3", IsActive: true, Tags: []string{"go", "postgres"}},
+				})
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr *models.PullRequest, _ []*models.User, _ []*models.PrEvent) error {
+						assert.Equal(t, []string{"go"}, pr.RequiredTags)
+						return nil
+					})
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "skilled first, then the rest",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1", RequiredTags: []string{"go", "postgres"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				createSetup(m, "random", []*models.User{
+					{UserId: 2, SystemId: "u2", IsActive: true, Tags: []string{"go"}},
+					{UserId: 3, SystemId: "u3", IsActive: true, Tags: []string{"go", "postgres"}},
+				})
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u3", "u2"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "nobody matches falls back to random",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1", RequiredTags: []string{"rust"}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				// least loaded would ask for review counts, random does not
+				createSetup(m, "least_loaded", []*models.User{
+					{UserId: 2, SystemId: "u2", IsActive: true},
+					{UserId: 3, SystemId: "u3", IsActive: true, Tags: []string{"go"}},
+				})
+				m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"u3", "u2"}, out.AssignedReviewers)
+			},
+		},
+		{
+			name: "invalid tag",
+			dto:  &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1", RequiredTags: []string{"  "}},
+			mockSetup: func(m *mocks.MockRepositoryInterface) {
+				createSetup(m, "random", nil)
+			},
+			check: func(t *testing.T, out *models.OutputCreatePullRequestDTO, err error) {
+				assert.Nil(t, out)
+				assert.Equal(t, appErrors.ErrInvalidTag, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(m, usecase.WithSeeds(usecase.FixedSeed(7)))
+			tt.mockSetup(m)
+
+			out, err := uc.CreatePullRequest(context.Background(), tt.dto)
+			tt.check(t, out, err)
+		})
+	}
+
+	t.Run("add and remove tags", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(&models.User{SystemId: "u1", Tags: []string{"go"}}, nil)
+		m.EXPECT().SetUserTags(gomock.Any(), "u1", []string{"go", "sql"}).
+			Return(&models.User{SystemId: "u1", Tags: []string{"go", "sql"}}, nil)
+
+		out, err := uc.AddUserTags(context.Background(), &models.UserTagsDTO{UserId: "u1", Tags: []string{"SQL", "go"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "sql"}, out.Tags)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(&models.User{SystemId: "u1", Tags: []string{"go", "sql"}}, nil)
+		m.EXPECT().SetUserTags(gomock.Any(), "u1", []string{"sql"}).
+			Return(&models.User{SystemId: "u1", Tags: []string{"sql"}}, nil)
+
+		out, err = uc.RemoveUserTags(context.Background(), &models.UserTagsDTO{UserId: "u1", Tags: []string{"go"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"sql"}, out.Tags)
+	})
+
+	t.Run("set tags of unknown user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().SetUserTags(gomock.Any(), "u9", []string{}).Return(nil, nil)
+
+		out, err := uc.SetUserTags(context.Background(), &models.UserTagsDTO{UserId: "u9"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+}
+
+func TestUseCase_Unavailability(t *testing.T) {
+	t.Run("unavailable teammate is not picked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 2, SystemId: "u2", IsActive: true, Unavailable: true},
+			{UserId: 3, SystemId: "u3", IsActive: true},
+		}, nil)
+		m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return(nil, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		out, err := uc.AddUnavailability(context.Background(), &models.UnavailabilityDTO{
+			UserId:   "u1",
+			StartsAt: "2025-07-10T00:00:00Z",
+			EndsAt:   "2025-07-01T00:00:00Z",
+		})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrInvalidPeriod, err)
+
+		out, err = uc.AddUnavailability(context.Background(), &models.UnavailabilityDTO{UserId: "u1", StartsAt: "tomorrow"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrParseData, err)
+	})
+
+	t.Run("add period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").Return(&models.User{UserId: 1, SystemId: "u1"}, nil)
+		m.EXPECT().AddUnavailability(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *models.Unavailability) error {
+				assert.Equal(t, time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC), p.StartsAt)
+				p.PeriodId = 4
+				return nil
+			})
+
+		out, err := uc.AddUnavailability(context.Background(), &models.UnavailabilityDTO{
+			UserId:   "u1",
+			StartsAt: "2025-07-01T12:00:00+03:00",
+			EndsAt:   "2025-07-14T00:00:00Z",
+			Reason:   " vacation ",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.UnavailabilityDTO{
+			PeriodId: 4,
+			UserId:   "u1",
+			StartsAt: "2025-07-01T09:00:00Z",
+			EndsAt:   "2025-07-14T00:00:00Z",
+			Reason:   "vacation",
+		}, *out)
+	})
+
+	t.Run("job moves open reviews", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		user := &models.User{UserId: 10, SystemId: "u1", TeamId: 1, IsActive: true, Unavailable: true}
+		m.EXPECT().GetStartedUnavailability(gomock.Any()).
+			Return([]*models.Unavailability{{PeriodId: 4, User: user, Reason: "vacation"}}, nil)
+		m.EXPECT().GetListReviewsByUserId(gomock.Any(), 10).
+			Return([]*models.PullRequest{
+				{SystemId: "PR1", Status: "OPEN"},
+				{SystemId: "PR2", Status: "OPEN"},
+				{SystemId: "PR3", Status: "MERGED"},
+			}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+			Return(&models.PullRequest{
+				PullRequestId:     1,
+				SystemId:          "PR1",
+				AuthorSystemId:    "u5",
+				AuthorTeamId:      1,
+				AssigneeReviewers: []*models.User{{UserId: 10, SystemId: "u1"}},
+			}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR2").
+			Return(&models.PullRequest{
+				PullRequestId:     2,
+				SystemId:          "PR2",
+				AuthorSystemId:    "u3",
+				AuthorTeamId:      1,
+				AssigneeReviewers: []*models.User{{UserId: 10, SystemId: "u1"}},
+			}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 1).
+			Return(&models.Team{TeamId: 1, ReviewerStrategy: "random"}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 1).
+			Return([]*models.User{
+				{UserId: 10, SystemId: "u1", IsActive: true, Unavailable: true},
+				{UserId: 12, SystemId: "u2", IsActive: true, Unavailable: true},
+				{UserId: 13, SystemId: "u3", IsActive: true},
+			}, nil)
+
+		// PR2 is authored by the only available teammate, so it keeps its reviewer
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 10, 13, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int, _ int, _ int, events []*models.PrEvent) error {
+				assert.Len(t, events, 1)
+				assert.Equal(t, "unavailability-job", events[0].Actor)
+				assert.Equal(t, "reviewer is unavailable: vacation", events[0].Reason)
+				return nil
+			})
+		// PR2 is still with u1, so the period stays pending for the next run
+
+		assert.NoError(t, uc.ReassignUnavailableReviews(context.Background()))
+	})
+
+	t.Run("job goes on after a failed period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		u1 := &models.User{UserId: 10, SystemId: "u1", TeamId: 1, IsActive: true, Unavailable: true}
+		u2 := &models.User{UserId: 12, SystemId: "u2", TeamId: 1, IsActive: true, Unavailable: true}
+		m.EXPECT().GetStartedUnavailability(gomock.Any()).
+			Return([]*models.Unavailability{{PeriodId: 4, User: u1}, {PeriodId: 5, User: u2}}, nil)
+		m.EXPECT().GetListReviewsByUserId(gomock.Any(), 10).
+			Return([]*models.PullRequest{{SystemId: "PR1", Status: "OPEN"}}, nil)
+		m.EXPECT().GetListReviewsByUserId(gomock.Any(), 12).
+			Return([]*models.PullRequest{{SystemId: "PR2", Status: "OPEN"}}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+			Return(&models.PullRequest{
+				PullRequestId:     1,
+				SystemId:          "PR1",
+				AuthorSystemId:    "u5",
+				AuthorTeamId:      1,
+				AssigneeReviewers: []*models.User{{UserId: 10, SystemId: "u1"}},
+			}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR2").
+			Return(&models.PullRequest{
+				PullRequestId:     2,
+				SystemId:          "PR2",
+				AuthorSystemId:    "u5",
+				AuthorTeamId:      1,
+				AssigneeReviewers: []*models.User{{UserId: 12, SystemId: "u2"}},
+			}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 1).
+			Return(&models.Team{TeamId: 1, ReviewerStrategy: "random"}, nil).Times(2)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 1).
+			Return([]*models.User{
+				{UserId: 10, SystemId: "u1", IsActive: true, Unavailable: true},
+				{UserId: 12, SystemId: "u2", IsActive: true, Unavailable: true},
+				{UserId: 13, SystemId: "u3", IsActive: true},
+			}, nil).Times(2)
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 10, 13, gomock.Any()).Return(errors.New("connection reset"))
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 2, 12, 13, gomock.Any()).Return(nil)
+		m.EXPECT().MarkUnavailabilityHandled(gomock.Any(), 5).Return(nil)
+
+		assert.Equal(t, appErrors.ErrServerError, uc.ReassignUnavailableReviews(context.Background()))
+	})
+
+	t.Run("remove unknown period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().DeleteUnavailability(gomock.Any(), 9).Return(false, nil)
+
+		err := uc.RemoveUnavailability(context.Background(), &models.RemoveUnavailabilityDTO{PeriodId: 9})
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+}
+
+func TestUseCase_ReviewCapacity(t *testing.T) {
+	t.Run("member at capacity is skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MinReviewers: 2, MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 2, SystemId: "u2", IsActive: true, MaxOpenReviews: intPtr(2), OpenReviews: 2},
+			{UserId: 3, SystemId: "u3", IsActive: true, MaxOpenReviews: intPtr(2), OpenReviews: 1},
+		}, nil)
+		m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return(nil, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+		assert.True(t, out.NotEnoughReviewers)
+		assert.True(t, out.CapacityLimited)
+	})
+
+	t.Run("shortfall without limits is not capped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 3, SystemId: "u3", IsActive: true, OpenReviews: 7},
+		}, nil)
+		m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return(nil, nil)
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+		assert.False(t, out.CapacityLimited)
+	})
+
+	t.Run("reassign finds only full reviewers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+			Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5}, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").
+			Return(&models.PullRequest{
+				PullRequestId:     1,
+				SystemId:          "PR1",
+				AuthorSystemId:    "u1",
+				AuthorTeamId:      5,
+				Status:            "OPEN",
+				AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+			}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", ReassignPool: models.ReassignPoolAuthorTeam}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 2, SystemId: "u2", IsActive: true},
+			{UserId: 3, SystemId: "u3", IsActive: true, MaxOpenReviews: intPtr(0)},
+		}, nil)
+		m.EXPECT().DeleteReview(gomock.Any(), 1, 2, gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "-", out.ReplacedBy)
+		assert.True(t, out.CapacityLimited)
+	})
+
+	t.Run("negative capacity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		out, err := uc.SetMaxOpenReviews(context.Background(), &models.SetMaxOpenReviewsDTO{UserId: "u1", MaxOpenReviews: intPtr(-1)})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrInvalidCapacity, err)
+	})
+}
+
+func TestUseCase_LeastPaired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(m)
+
+	m.EXPECT().PullRequestExists(gomock.Any(), "PR1").Return(false, nil)
+	m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+		Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+	m.EXPECT().GetTeamById(gomock.Any(), 5).
+		Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "least_paired", MaxReviewers: 1, PairingLookbackDays: 14}, nil)
+	m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+		{UserId: 2, SystemId: "u2", IsActive: true},
+		{UserId: 3, SystemId: "u3", IsActive: true},
+	}, nil)
+	m.EXPECT().GetPairings(gomock.Any(), 1, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, userIds []int, since time.Time) (map[int]models.Pairing, error) {
+			assert.ElementsMatch(t, []int{2, 3}, userIds)
+			assert.WithinDuration(t, time.Now().Add(-14*24*time.Hour), since, time.Minute)
+			return map[int]models.Pairing{2: {Reviews: 4, LastAt: time.Now()}}, nil
+		})
+	m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+	out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR1", AuthorId: "u1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u3"}, out.AssignedReviewers)
+
+	t.Run("partner candidates use the partner lookback", func(t *testing.T) {
+		m.EXPECT().PullRequestExists(gomock.Any(), "PR2").Return(false, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u1").
+			Return(&models.User{UserId: 1, TeamId: 5, SystemId: "u1"}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "least_paired", MaxReviewers: 1, PairingLookbackDays: 14}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{{UserId: 1, SystemId: "u1", IsActive: true}}, nil)
+		m.EXPECT().GetPartnerTeams(gomock.Any(), 5).Return([]*models.Team{
+			{TeamId: 7, TeamName: "frontend", ReviewerStrategy: "least_paired", PairingLookbackDays: 7},
+		}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 7).Return([]*models.User{
+			{UserId: 4, SystemId: "u4", IsActive: true},
+			{UserId: 5, SystemId: "u5", IsActive: true},
+		}, nil)
+		m.EXPECT().GetPairings(gomock.Any(), 1, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int, userIds []int, since time.Time) (map[int]models.Pairing, error) {
+				assert.ElementsMatch(t, []int{4, 5}, userIds)
+				assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), since, time.Minute)
+				return map[int]models.Pairing{4: {Reviews: 2, LastAt: time.Now()}}, nil
+			})
+		m.EXPECT().CreatePullRequestAndReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+		out, err := uc.CreatePullRequest(context.Background(), &models.InputCreatePullRequestDTO{PullRequestId: "PR2", AuthorId: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u5"}, out.AssignedReviewers)
+	})
+
+	t.Run("invalid lookback", func(t *testing.T) {
+		out, err := uc.SetPairingLookback(context.Background(), &models.SetPairingLookbackDTO{TeamName: "backend"})
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrInvalidPairingLookback, err)
+	})
+}
+
+func TestUseCase_AssignmentExplanation(t *testing.T) {
+	t.Run("reassign records exclusion reasons", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m, usecase.WithSeeds(usecase.FixedSeed(7)))
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+			Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).
+			Return(&models.Team{TeamId: 5, ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 1, SystemId: "u1", IsActive: true},
+			{UserId: 2, SystemId: "u2", IsActive: true},
+			{UserId: 3, SystemId: "u3"},
+			{UserId: 4, SystemId: "u4", IsActive: true, Unavailable: true},
+			{UserId: 5, SystemId: "u5", IsActive: true, MaxOpenReviews: intPtr(1), OpenReviews: 1},
+			{UserId: 6, SystemId: "u6", IsActive: true},
+		}, nil)
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 2, 6, gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *models.AssignmentExplanation) error {
+				assert.Equal(t, 1, e.PullRequestId)
+				assert.Equal(t, models.AssignmentReassigned, e.Trigger)
+				assert.Equal(t, "random", e.Strategy)
+				assert.Equal(t, int64(7), *e.Seed)
+				assert.Equal(t, 2, e.ReplacedReviewerId)
+				assert.Equal(t, []*models.AssignmentCandidate{
+					{UserId: 1, SystemId: "u1", ExcludedReason: models.ExcludedAuthor},
+					{UserId: 2, SystemId: "u2", ExcludedReason: models.ExcludedAlreadyAssigned},
+					{UserId: 3, SystemId: "u3", ExcludedReason: models.ExcludedInactive},
+					{UserId: 4, SystemId: "u4", ExcludedReason: models.ExcludedUnavailable},
+					{UserId: 5, SystemId: "u5", ExcludedReason: models.ExcludedAtCapacity},
+					{UserId: 6, SystemId: "u6", Selected: true},
+				}, e.Candidates)
+				return nil
+			})
+
+		out, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "u6", out.ReplacedBy)
+	})
+
+	t.Run("explanations of a pull request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		seed := int64(7)
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{PullRequestId: 1, SystemId: "PR1"}, nil)
+		m.EXPECT().GetAssignmentExplanations(gomock.Any(), 1).Return([]*models.AssignmentExplanation{
+			{
+				Trigger:  models.AssignmentCreated,
+				Actor:    "alice",
+				Strategy: "random",
+				Seed:     &seed,
+				Candidates: []*models.AssignmentCandidate{
+					{SystemId: "u1", ExcludedReason: models.ExcludedAuthor},
+					{SystemId: "u2", Selected: true, Pool: models.PoolHome},
+					{SystemId: "u3"},
+				},
+				CreatedAt: createdAt,
+			},
+			{
+				Trigger:                  models.AssignmentReassigned,
+				Strategy:                 models.StrategyRequested,
+				ReplacedReviewerSystemId: "u2",
+				Candidates:               []*models.AssignmentCandidate{{SystemId: "u3", Selected: true}},
+				CreatedAt:                createdAt,
+			},
+		}, nil)
+
+		out, err := uc.GetAssignmentExplanation(context.Background(), "PR1")
+		assert.NoError(t, err)
+		assert.Equal(t, &models.AssignmentExplanationsDTO{
+			PullRequestId: "PR1",
+			Assignments: []models.AssignmentExplanationDTO{
+				{
+					Trigger:           models.AssignmentCreated,
+					Actor:             "alice",
+					Strategy:          "random",
+					Seed:              &seed,
+					SelectedReviewers: []string{"u2"},
+					Candidates: []models.AssignmentCandidateDTO{
+						{UserId: "u2", Selected: true, Pool: models.PoolHome},
+						{UserId: "u3"},
+					},
+					Excluded:  []models.ExcludedCandidateDTO{{UserId: "u1", Reason: models.ExcludedAuthor}},
+					CreatedAt: "2025-01-02T03:04:05Z",
+				},
+				{
+					Trigger:            models.AssignmentReassigned,
+					Strategy:           models.StrategyRequested,
+					ReplacedReviewerId: "u2",
+					SelectedReviewers:  []string{"u3"},
+					Candidates:         []models.AssignmentCandidateDTO{{UserId: "u3", Selected: true}},
+					Excluded:           []models.ExcludedCandidateDTO{},
+					CreatedAt:          "2025-01-02T03:04:05Z",
+				},
+			},
+		}, out)
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR404").Return(nil, nil)
+
+		out, err := uc.GetAssignmentExplanation(context.Background(), "PR404")
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+}
+
+type failingSender struct {
+	sent []int
+}
+
+func (s *failingSender) Send(_ context.Context, d *models.WebhookDelivery) error {
+	s.sent = append(s.sent, d.DeliveryId)
+	return errors.New("connection refused")
+}
+
+func TestUseCase_Webhooks(t *testing.T) {
+	t.Run("add validates and normalizes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		for _, dto := range []*models.WebhookDTO{
+			{Url: "ftp://example.com", Secret: "s", EventTypes: []string{models.WebhookPullRequestCreated}},
+			{Url: "https://example.com/hook", EventTypes: []string{models.WebhookPullRequestCreated}},
+			{Url: "https://example.com/hook", Secret: "s"},
+			{Url: "https://example.com/hook", Secret: "s", EventTypes: []string{"pr.deleted"}},
+		} {
+			out, err := uc.AddWebhook(context.Background(), dto)
+			assert.Nil(t, out)
+			assert.Equal(t, appErrors.ErrInvalidWebhook, err)
+		}
+
+		m.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, s *models.WebhookSubscription) error {
+				assert.Equal(t, "s3cret", s.Secret)
+				assert.Equal(t, []string{models.WebhookPullRequestMerged, models.WebhookReviewerAssigned}, s.EventTypes)
+				s.SubscriptionId = 3
+				return nil
+			})
+
+		out, err := uc.AddWebhook(context.Background(), &models.WebhookDTO{
+			Url:        "https://example.com/hook",
+			Secret:     "s3cret",
+			EventTypes: []string{models.WebhookReviewerAssigned, models.WebhookPullRequestMerged, models.WebhookReviewerAssigned},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, out.SubscriptionId)
+		assert.Empty(t, out.Secret)
+	})
+
+	t.Run("remove unknown webhook", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().DeleteWebhookSubscription(gomock.Any(), 9).Return(false, nil)
+
+		err := uc.RemoveWebhook(context.Background(), &models.RemoveWebhookDTO{SubscriptionId: 9})
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+
+	t.Run("dispatch delivers signed payload", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		payload := []byte(`{"event_type":"reviewer.assigned","new_reviewer_id":"u2"}`)
+		received := make(chan *http.Request, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, payload, body)
+			received <- r
+		}))
+		defer srv.Close()
+
+		m.EXPECT().ClaimWebhookDeliveries(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.WebhookDelivery{
+			{DeliveryId: 1, Url: srv.URL, Secret: "s3cret", EventType: models.WebhookReviewerAssigned, Payload: payload, Attempts: 1},
+		}, nil)
+		m.EXPECT().MarkWebhookDelivered(gomock.Any(), 1).Return(nil)
+
+		assert.NoError(t, uc.DispatchWebhooks(context.Background()))
+
+		r := <-received
+		assert.Equal(t, webhook.Sign("s3cret", payload), r.Header.Get(webhook.SignatureHeader))
+		assert.Equal(t, models.WebhookReviewerAssigned, r.Header.Get(webhook.EventHeader))
+	})
+
+	t.Run("failures are retried until dead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		sender := &failingSender{}
+		uc := usecase.NewUseCase(m, usecase.WithWebhookSender(sender))
+
+		m.EXPECT().ClaimWebhookDeliveries(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.WebhookDelivery{
+			{DeliveryId: 1, Attempts: 2},
+			{DeliveryId: 2, Attempts: webhook.MaxAttempts},
+		}, nil)
+		m.EXPECT().RetryWebhookDelivery(gomock.Any(), 1, "connection refused", time.Minute).Return(nil)
+		m.EXPECT().MarkWebhookDead(gomock.Any(), 2, "connection refused").Return(nil)
+
+		assert.NoError(t, uc.DispatchWebhooks(context.Background()))
+		assert.Equal(t, []int{1, 2}, sender.sent)
+	})
+}
+
+func TestUseCase_HandlePullRequestEvent(t *testing.T) {
+	event := func(action string) *models.InboundPullRequestEvent {
+		return &models.InboundPullRequestEvent{
+			Provider:      models.ProviderGitHub,
+			DeliveryId:    "d-1",
+			Action:        action,
+			PullRequestId: "octo/app#12",
+			Title:         "Add rate limiter",
+			AuthorLogin:   "u1",
+		}
+	}
+
+	t.Run("unsupported action is ignored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := usecase.NewUseCase(mocks.NewMockRepositoryInterface(ctrl))
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), event("labeled"))
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundIgnored, out.Result)
+
+		out, err = uc.HandlePullRequestEvent(context.Background(), event(models.InboundReviewRequested))
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundIgnored, out.Result)
+	})
+
+	t.Run("redelivery is a duplicate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(false, nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), event(models.InboundOpened))
+		assert.NoError(t, err)
+		assert.Equal(t, &models.InboundWebhookResultDTO{
+			DeliveryId:    "d-1",
+			Action:        models.InboundOpened,
+			PullRequestId: "octo/app#12",
+			Result:        models.InboundDuplicate,
+		}, out)
+	})
+
+	t.Run("opened for a known PR is a duplicate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+		m.EXPECT().PullRequestExists(gomock.Any(), "octo/app#12").Return(true, nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), event(models.InboundOpened))
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundDuplicate, out.Result)
+	})
+
+	t.Run("merge bypasses the merge policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app#12").Return(&models.PullRequest{
+			PullRequestId:  1,
+			SystemId:       "octo/app#12",
+			AuthorSystemId: "u1",
+			AuthorTeamId:   5,
+			Status:         models.StatusOpen,
+		}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, RequiredApprovals: 1, MaxReviewers: 2}, nil)
+		m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int, events []*models.PrEvent) (sql.NullTime, error) {
+				assert.Equal(t, "github-webhook", events[0].Actor)
+				assert.Equal(t, "pull request force merged, bypassed: required_approvals", events[0].Reason)
+				return sql.NullTime{Time: time.Now(), Valid: true}, nil
+			})
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), event(models.InboundMerged))
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundProcessed, out.Result)
+	})
+
+	t.Run("review request skips assigned reviewers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		// gitlab_reviewers_first.json: u2 was assigned by the service before
+		// the code host listed any reviewer
+		requested := &models.InboundPullRequestEvent{
+			Provider:           models.ProviderGitLab,
+			DeliveryId:         "d-1",
+			Action:             models.InboundReviewRequested,
+			PullRequestId:      "octo/app!7",
+			AuthorLogin:        "u9",
+			RequestedReviewers: []string{"u2", "u4"},
+		}
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitLab, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app!7").
+			DoAndReturn(func(context.Context, string) (*models.PullRequest, error) {
+				return &models.PullRequest{
+					PullRequestId:     1,
+					SystemId:          "octo/app!7",
+					AuthorSystemId:    "u1",
+					AuthorTeamId:      5,
+					Status:            models.StatusOpen,
+					AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+				}, nil
+			}).Times(2)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, MaxReviewers: 2}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u4").Return(&models.User{UserId: 4, SystemId: "u4", IsActive: true}, nil)
+		m.EXPECT().UpdateReviewers(gomock.Any(), 1, []*models.User{{UserId: 4, SystemId: "u4", IsActive: true}}, gomock.Nil(), gomock.Len(1)).Return(nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), requested)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundProcessed, out.Result)
+	})
+
+	t.Run("review request that drops the draft flag marks the PR ready first", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		// gitlab_ready_reviewers.json
+		requested := &models.InboundPullRequestEvent{
+			Provider:           models.ProviderGitLab,
+			DeliveryId:         "d-1",
+			Action:             models.InboundReviewRequested,
+			PullRequestId:      "octo/app!7",
+			AuthorLogin:        "u9",
+			RequestedReviewers: []string{"u4"},
+			Ready:              true,
+		}
+
+		status := models.StatusDraft
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitLab, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app!7").
+			DoAndReturn(func(context.Context, string) (*models.PullRequest, error) {
+				return &models.PullRequest{
+					PullRequestId:     1,
+					SystemId:          "octo/app!7",
+					AuthorSystemId:    "u1",
+					AuthorTeamId:      5,
+					Status:            status,
+					AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+				}, nil
+			}).Times(3)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, MaxReviewers: 2}, nil).Times(2)
+		gomock.InOrder(
+			m.EXPECT().SetPullRequestStatus(gomock.Any(), 1, models.StatusOpen, gomock.Len(0), gomock.Len(1)).
+				DoAndReturn(func(context.Context, int, string, []*models.User, []*models.PrEvent) error {
+					status = models.StatusOpen
+					return nil
+				}),
+			m.EXPECT().UpdateReviewers(gomock.Any(), 1, gomock.Len(1), gomock.Nil(), gomock.Len(1)).Return(nil),
+		)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u4").Return(&models.User{UserId: 4, SystemId: "u4", IsActive: true}, nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), requested)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundProcessed, out.Result)
+	})
+
+	t.Run("review request of assigned reviewers only is a duplicate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		requested := event(models.InboundReviewRequested)
+		requested.RequestedReviewers = []string{"u2"}
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app#12").Return(&models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "octo/app#12",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2"}},
+		}, nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), requested)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InboundDuplicate, out.Result)
+	})
+
+	t.Run("close or reopen in the current status is a duplicate", func(t *testing.T) {
+		for action, status := range map[string]string{
+			models.InboundClosed:   models.StatusClosed,
+			models.InboundReopened: models.StatusOpen,
+		} {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockRepositoryInterface(ctrl)
+			uc := usecase.NewUseCase(m)
+
+			m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+			m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app#12").Return(&models.PullRequest{
+				PullRequestId: 1,
+				SystemId:      "octo/app#12",
+				AuthorTeamId:  5,
+				Status:        status,
+			}, nil)
+
+			out, err := uc.HandlePullRequestEvent(context.Background(), event(action))
+			assert.NoError(t, err, action)
+			assert.Equal(t, models.InboundDuplicate, out.Result, action)
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("close of a merged PR is forgotten", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app#12").Return(&models.PullRequest{
+			PullRequestId: 1,
+			SystemId:      "octo/app#12",
+			AuthorTeamId:  5,
+			Status:        models.StatusMerged,
+		}, nil)
+		m.EXPECT().ForgetInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(nil)
+
+		_, err := uc.HandlePullRequestEvent(context.Background(), event(models.InboundClosed))
+		assert.ErrorIs(t, err, appErrors.ErrInvalidStatusTransition)
+	})
+
+	t.Run("failed delivery is forgotten", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().RecordInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(true, nil)
+		m.EXPECT().GetPullRequestById(gomock.Any(), "octo/app#12").Return(nil, nil)
+		m.EXPECT().ForgetInboundDelivery(gomock.Any(), models.ProviderGitHub, "d-1").Return(nil)
+
+		out, err := uc.HandlePullRequestEvent(context.Background(), event(models.InboundClosed))
+		assert.Nil(t, out)
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+}
+
+// recordingNotifier hands every sent message over a channel, notifications
+// are sent in the background.
+type recordingNotifier struct {
+	sent chan notify.Message
+}
+
+func newRecordingNotifier() *recordingNotifier {
+	return &recordingNotifier{sent: make(chan notify.Message, 8)}
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, to *models.Contact, msg notify.Message) error {
+	n.sent <- msg
+	return nil
+}
+
+// wait collects count messages keyed by their text.
+func (n *recordingNotifier) wait(t *testing.T, count int) map[string]notify.Message {
+	t.Helper()
+
+	got := make(map[string]notify.Message, count)
+	for range count {
+		select {
+		case msg := <-n.sent:
+			got[msg.Text] = msg
+		case <-time.After(time.Second):
+			t.Fatalf("got %d of %d notifications", len(got), count)
+		}
+	}
+	return got
+}
+
+func TestUseCase_Notifications(t *testing.T) {
+	t.Run("invalid contacts are rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := usecase.NewUseCase(mocks.NewMockRepositoryInterface(ctrl))
+
+		_, err := uc.SetContacts(context.Background(), &models.UserContactsDTO{UserId: "u2", Email: "bob"})
+		assert.Equal(t, appErrors.ErrInvalidContact, err)
+
+		_, err = uc.SetContacts(context.Background(), &models.UserContactsDTO{UserId: "u2", SlackWebhookUrl: "ftp://hooks"})
+		assert.Equal(t, appErrors.ErrInvalidContact, err)
+	})
+
+	t.Run("set and get contacts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 11, SystemId: "u2"}, nil).Times(2)
+		m.EXPECT().SetUserContacts(gomock.Any(), &models.Contact{
+			UserId:          11,
+			SlackWebhookUrl: "https://hooks.example.com/T1",
+			Email:           "bob@example.com",
+		}).Return(nil)
+		m.EXPECT().GetUserContacts(gomock.Any(), []int{11}).Return([]*models.Contact{{
+			UserId:   11,
+			SystemId: "u2",
+			Email:    "bob@example.com",
+		}}, nil)
+
+		out, err := uc.SetContacts(context.Background(), &models.UserContactsDTO{
+			UserId:          "u2",
+			SlackWebhookUrl: " https://hooks.example.com/T1 ",
+			Email:           "bob@example.com",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://hooks.example.com/T1", out.SlackWebhookUrl)
+
+		out, err = uc.GetContacts(context.Background(), "u2")
+		assert.NoError(t, err)
+		assert.Equal(t, &models.UserContactsDTO{UserId: "u2", Email: "bob@example.com"}, out)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		uc := usecase.NewUseCase(m)
+
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u9").Return(nil, nil)
+
+		_, err := uc.GetContacts(context.Background(), "u9")
+		assert.Equal(t, appErrors.ErrResourceNotFound, err)
+	})
+
+	t.Run("reassign notifies both reviewers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		n := newRecordingNotifier()
+		uc := usecase.NewUseCase(m, usecase.WithNotifier(n))
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			PullRequestName:   "Add search",
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+		}, nil)
+		m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, ReviewerStrategy: "least_loaded", MaxReviewers: 2}, nil)
+		m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+			{UserId: 10, SystemId: "u1", IsActive: true},
+			{UserId: 11, SystemId: "u2", IsActive: true},
+			{UserId: 13, SystemId: "u4", IsActive: true},
+		}, nil)
+		m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{13}).Return(map[int]int{}, nil)
+		m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 11, 13, gomock.Any()).Return(nil)
+		m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().GetUserContacts(gomock.Any(), []int{13}).Return([]*models.Contact{{UserId: 13, SystemId: "u4", UserName: "Dan"}}, nil)
+		m.EXPECT().GetUserContacts(gomock.Any(), []int{11}).Return([]*models.Contact{{UserId: 11, SystemId: "u2", UserName: "Bob"}}, nil)
+
+		_, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+		assert.NoError(t, err)
+
+		got := n.wait(t, 2)
+		assert.Contains(t, got, "Hi Dan, you were assigned to review Add search (PR1) by u1.")
+		assert.Contains(t, got, "Hi Bob, you no longer review Add search (PR1), u4 took it over.")
+	})
+
+	t.Run("merge notifies reviewers and the author", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockRepositoryInterface(ctrl)
+		n := newRecordingNotifier()
+		uc := usecase.NewUseCase(m, usecase.WithNotifier(n))
+
+		m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+			PullRequestId:     1,
+			SystemId:          "PR1",
+			PullRequestName:   "Add search",
+			AuthorId:          10,
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+		}, nil)
+		m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, MaxReviewers: 2}, nil)
+		m.EXPECT().SetMergedStatusPullRequest(gomock.Any(), 1, gomock.Any()).Return(sql.NullTime{Time: time.Now(), Valid: true}, nil)
+		m.EXPECT().GetUserContacts(gomock.Any(), []int{11, 10}).Return([]*models.Contact{
+			{UserId: 10, SystemId: "u1", UserName: "Alice"},
+			{UserId: 11, SystemId: "u2", UserName: "Bob"},
+		}, nil)
+
+		_, err := uc.MergePullRequest(context.Background(), &models.InputMergePullRequestDTO{PullRequestId: "PR1"})
+		assert.NoError(t, err)
+
+		got := n.wait(t, 2)
+		assert.Equal(t, notify.Message{
+			Subject: "Merged: Add search",
+			Text:    "Hi Alice, Add search (PR1) by u1 was merged.",
+		}, got["Hi Alice, Add search (PR1) by u1 was merged."])
+		assert.Contains(t, got, "Hi Bob, Add search (PR1) by u1 was merged.")
+	})
+}
+
+func TestUseCase_LiveEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(m)
+
+	all := uc.SubscribeEvents(context.Background(), &models.LiveEventFilterDTO{})
+	defer all.Close()
+	bob := uc.SubscribeEvents(context.Background(), &models.LiveEventFilterDTO{UserId: "u2"})
+	defer bob.Close()
+
+	m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+		PullRequestId:     1,
+		SystemId:          "PR1",
+		AuthorSystemId:    "u1",
+		AuthorTeamId:      5,
+		Status:            models.StatusOpen,
+		AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+	}, nil)
+	m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 5}, nil)
+	m.EXPECT().GetTeamById(gomock.Any(), 5).Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "least_loaded", MaxReviewers: 2}, nil)
+	m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+		{UserId: 10, SystemId: "u1", IsActive: true},
+		{UserId: 11, SystemId: "u2", IsActive: true},
+		{UserId: 13, SystemId: "u4", IsActive: true},
+	}, nil)
+	m.EXPECT().GetOpenReviewCounts(gomock.Any(), []int{13}).Return(map[int]int{}, nil)
+	m.EXPECT().ReplaceReviewers(gomock.Any(), 1, 11, 13, gomock.Any()).Return(nil)
+	m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := uc.Reassign(context.Background(), &models.InputReassignDTO{PullRequestId: "PR1", UserId: "u2"})
+	assert.NoError(t, err)
+
+	m.EXPECT().SetIsActive(gomock.Any(), "u3", false).
+		Return(&models.User{UserId: 12, SystemId: "u3", TeamName: "frontend"}, nil)
+
+	_, err = uc.SetIsActive(context.Background(), &models.SetIsActiveDTO{UserID: "u3", IsActive: false})
+	assert.NoError(t, err)
+
+	replaced := <-all.Events
+	assert.Equal(t, models.LiveReviewerReplaced, replaced.Type)
+	assert.Equal(t, "backend", replaced.TeamName)
+	assert.Equal(t, "PR1", replaced.PullRequestId)
+	assert.Equal(t, "u2", replaced.OldReviewerId)
+	assert.Equal(t, "u4", replaced.NewReviewerId)
+
+	activity := <-all.Events
+	assert.Equal(t, models.LiveUserActivity, activity.Type)
+	assert.Equal(t, "frontend", activity.TeamName)
+	assert.Equal(t, "u3", activity.UserId)
+	assert.Equal(t, false, *activity.IsActive)
+
+	assert.Equal(t, replaced, <-bob.Events)
+	assert.Empty(t, bob.Events)
+
+	t.Run("reconnect replays missed events", func(t *testing.T) {
+		sub := uc.SubscribeEvents(context.Background(), &models.LiveEventFilterDTO{
+			TeamName:    "frontend",
+			LastEventId: replaced.EventId - 1,
+		})
+		defer sub.Close()
+
+		assert.Equal(t, []models.LiveEventDTO{activity}, sub.Missed)
+	})
+}
+
+func TestUseCase_LiveEventsOfBulkChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockRepositoryInterface(ctrl)
+	uc := usecase.NewUseCase(m)
+
+	backend := uc.SubscribeEvents(context.Background(), &models.LiveEventFilterDTO{TeamName: "backend"})
+	defer backend.Close()
+	frontend := uc.SubscribeEvents(context.Background(), &models.LiveEventFilterDTO{TeamName: "frontend"})
+	defer frontend.Close()
+
+	// the reviewer is from another team than the author of the PR
+	m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+		Return(&models.User{UserId: 11, SystemId: "u2", TeamId: 8, TeamName: "frontend", IsActive: true}, nil)
+	m.EXPECT().GetListReviewsByUserId(gomock.Any(), 11).
+		Return([]*models.PullRequest{{SystemId: "PR1", Status: models.StatusOpen}}, nil)
+	m.EXPECT().GetPullRequestById(gomock.Any(), "PR1").Return(&models.PullRequest{
+		PullRequestId:     1,
+		SystemId:          "PR1",
+		AuthorSystemId:    "u1",
+		AuthorTeamId:      5,
+		Status:            models.StatusOpen,
+		AssigneeReviewers: []*models.User{{UserId: 11, SystemId: "u2"}},
+	}, nil)
+	m.EXPECT().GetTeamById(gomock.Any(), 5).
+		Return(&models.Team{TeamId: 5, TeamName: "backend", ReviewerStrategy: "random", MaxReviewers: 2}, nil)
+	m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return([]*models.User{
+		{UserId: 10, SystemId: "u1", IsActive: true},
+		{UserId: 13, SystemId: "u4", IsActive: true},
+	}, nil)
+	m.EXPECT().DeactivateUsers(gomock.Any(), []int{11}, gomock.Len(1), gomock.Len(1)).Return(nil)
+
+	_, err := uc.BulkDeactivate(context.Background(), &models.BulkDeactivateDTO{UserIds: []string{"u2"}})
+	assert.NoError(t, err)
+
+	replaced := <-backend.Events
+	assert.Equal(t, models.LiveReviewerReplaced, replaced.Type)
+	assert.Equal(t, "backend", replaced.TeamName)
+	assert.Equal(t, "u1", replaced.AuthorId)
+	assert.Equal(t, "u2", replaced.OldReviewerId)
+	assert.Equal(t, "u4", replaced.NewReviewerId)
+	assert.Empty(t, backend.Events)
+
+	activity := <-frontend.Events
+	assert.Equal(t, models.LiveUserActivity, activity.Type)
+	assert.Equal(t, "u2", activity.UserId)
+	assert.Empty(t, frontend.Events)
+}
+
+// seedSequence hands out 1, 2, 3...
+type seedSequence struct {
+	last int64
+}
+
+func (s *seedSequence) Seed() int64 {
+	s.last++
+	return s.last
+}
+
+func TestUseCase_ReplayBulkReplacement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockRepositoryInterface(ctrl)
+	ctx := context.Background()
+
+	team := &models.Team{TeamId: 5, ReviewerStrategy: "random", MaxReviewers: 2}
+	members := []*models.User{{UserId: 1, SystemId: "u1", IsActive: true}, {UserId: 2, SystemId: "u2", IsActive: true}}
+	for id := 3; id <= 8; id++ {
+		members = append(members, &models.User{UserId: id, SystemId: fmt.Sprintf("u%d", id), IsActive: true})
+	}
+	prIds := map[string]int{"PR1": 1, "PR2": 2, "PR3": 3}
+	openPR := func(systemId string) *models.PullRequest {
+		return &models.PullRequest{
+			PullRequestId:     prIds[systemId],
+			SystemId:          systemId,
+			AuthorSystemId:    "u1",
+			AuthorTeamId:      5,
+			Status:            models.StatusOpen,
+			AssigneeReviewers: []*models.User{{UserId: 2, SystemId: "u2", TeamId: 5}},
+		}
+	}
+
+	m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+		Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5, IsActive: true}, nil)
+	reviews := make([]*models.PullRequest, 0, len(prIds))
+	for _, id := range []string{"PR1", "PR2", "PR3"} {
+		reviews = append(reviews, &models.PullRequest{SystemId: id, Status: models.StatusOpen})
+		m.EXPECT().GetPullRequestById(gomock.Any(), id).Return(openPR(id), nil)
+	}
+	m.EXPECT().GetListReviewsByUserId(gomock.Any(), 2).Return(reviews, nil)
+	m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+	m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return(members, nil)
+
+	var changes []*models.ReviewerChange
+	m.EXPECT().DeactivateUsers(gomock.Any(), []int{2}, gomock.Len(3), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []int, c []*models.ReviewerChange, _ []*models.PrEvent) error {
+			changes = c
+			return nil
+		})
+
+	uc := usecase.NewUseCase(m, usecase.WithSeeds(&seedSequence{}))
+	_, err := uc.BulkDeactivate(ctx, &models.BulkDeactivateDTO{UserIds: []string{"u2"}})
+	assert.NoError(t, err)
+
+	seeds := make(map[int64]bool)
+	for _, change := range changes {
+		assert.NotNil(t, change.Seed)
+		assert.False(t, seeds[*change.Seed], "seeds are not shared between picks")
+		seeds[*change.Seed] = true
+
+		t.Run("replay "+change.PullRequestSystemId, func(t *testing.T) {
+			m.EXPECT().GetPullRequestById(gomock.Any(), change.PullRequestSystemId).Return(openPR(change.PullRequestSystemId), nil)
+			m.EXPECT().GetUserBySystemId(gomock.Any(), "u2").
+				Return(&models.User{UserId: 2, SystemId: "u2", TeamId: 5, IsActive: true}, nil)
+			m.EXPECT().GetTeamById(gomock.Any(), 5).Return(team, nil)
+			m.EXPECT().GetTeamMembers(gomock.Any(), 5).Return(members, nil)
+			m.EXPECT().ReplaceReviewers(gomock.Any(), change.PullRequestId, 2, change.NewReviewer.UserId, gomock.Any()).Return(nil)
+			m.EXPECT().AddAssignmentExplanation(gomock.Any(), gomock.Any()).Return(nil)
+
+			replay := usecase.NewUseCase(m, usecase.WithSeeds(usecase.FixedSeed(*change.Seed)))
+			out, err := replay.Reassign(ctx, &models.InputReassignDTO{PullRequestId: change.PullRequestSystemId, UserId: "u2"})
+			assert.NoError(t, err)
+			assert.Equal(t, change.NewReviewer.SystemId, out.ReplacedBy)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"PRmanager/internal/models"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// MaxAttempts is how many times a delivery is tried before it goes to the
+// dead letters.
+const MaxAttempts = 8
+
+const (
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// Sign returns the signature of body sent in SignatureHeader: the hex
+// HMAC-SHA256 of the body keyed with the subscription secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff is the delay before the next try of a delivery that failed on the
+// given attempt, doubling from 30 seconds up to an hour.
+func Backoff(attempt int) time.Duration {
+	d := baseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{http: &http.Client{Timeout: timeout}}
+}
+
+// Send posts the delivery payload to the subscription url. Any response but
+// 2xx is an error.
+func (c *Client) Send(ctx context.Context, d *models.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, d.EventType)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(d.DeliveryId))
+	req.Header.Set(SignatureHeader, Sign(d.Secret, d.Payload))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver answered %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package webhook_test
+
+import (
+	"PRmanager/internal/models"
+	"PRmanager/internal/usecase/webhook"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Send(t *testing.T) {
+	payload := []byte(`{"event_type":"pr.created","pull_request_id":"PR1"}`)
+
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := webhook.NewClient(time.Second).Send(context.Background(), &models.WebhookDelivery{
+		DeliveryId: 42,
+		Url:        srv.URL,
+		Secret:     "s3cret",
+		EventType:  models.WebhookPullRequestCreated,
+		Payload:    payload,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+	assert.Equal(t, "pr.created", got.Header.Get(webhook.EventHeader))
+	assert.Equal(t, "42", got.Header.Get(webhook.DeliveryHeader))
+	assert.Equal(t, webhook.Sign("s3cret", payload), got.Header.Get(webhook.SignatureHeader))
+
+	t.Run("receiver error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		err := webhook.NewClient(time.Second).Send(context.Background(), &models.WebhookDelivery{Url: srv.URL, Payload: payload})
+		assert.EqualError(t, err, "receiver answered 502 Bad Gateway")
+	})
+
+	t.Run("slow receiver times out", func(t *testing.T) {
+		release := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer srv.Close()
+		defer close(release)
+
+		err := webhook.NewClient(50*time.Millisecond).Send(context.Background(), &models.WebhookDelivery{Url: srv.URL, Payload: payload})
+		assert.Error(t, err)
+	})
+}
+
+func TestSign(t *testing.T) {
+	// echo -n 'hello' | openssl dgst -sha256 -hmac key
+	assert.Equal(t, "sha256=9307b3b915efb5171ff14d8cb55fbcc798c6c0ef1456d66ded1a6aa723a58b7b", webhook.Sign("key", []byte("hello")))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhook.Backoff(1))
+	assert.Equal(t, time.Minute, webhook.Backoff(2))
+	assert.Equal(t, 8*time.Minute, webhook.Backoff(5))
+	assert.Equal(t, time.Hour, webhook.Backoff(8))
+}
@@ -0,0 +1,4 @@
+ALTER TABLE teams
+    ADD COLUMN min_reviewers INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_reviewers INT NOT NULL DEFAULT 2,
+    ADD CONSTRAINT teams_reviewers_count_check CHECK (min_reviewers >= 0 AND max_reviewers >= min_reviewers);
@@ -0,0 +1,3 @@
+-- users removed from a team keep their history and stay without a team
+ALTER TABLE users
+    ALTER COLUMN team_id DROP NOT NULL;
@@ -0,0 +1,13 @@
+-- append-only history of pull request changes, rows are never updated
+CREATE TABLE pr_events (
+    event_id        SERIAL PRIMARY KEY,
+    pull_request_id INT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL,
+    actor           TEXT,
+    old_reviewer_id INT REFERENCES users(user_id),
+    new_reviewer_id INT REFERENCES users(user_id),
+    reason          TEXT NOT NULL DEFAULT '',
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX pr_events_pull_request_idx ON pr_events (pull_request_id, event_id);
@@ -0,0 +1,4 @@
+INSERT INTO statuses (status) VALUES
+('DRAFT'),
+('CLOSED')
+ON CONFLICT DO NOTHING;
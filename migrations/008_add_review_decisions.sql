@@ -0,0 +1,7 @@
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN decision   TEXT CHECK (decision IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    ADD COLUMN decided_at TIMESTAMP;
+
+-- 0 means merging does not wait for approvals
+ALTER TABLE teams
+    ADD COLUMN required_approvals INT NOT NULL DEFAULT 0 CHECK (required_approvals >= 0);
@@ -0,0 +1,5 @@
+-- all rules are off by default, so existing teams merge as before
+ALTER TABLE teams
+    ADD COLUMN require_reviewer     BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN no_changes_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN no_self_approval     BOOLEAN NOT NULL DEFAULT FALSE;
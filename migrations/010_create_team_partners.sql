@@ -0,0 +1,9 @@
+-- partner teams lend reviewers when the home team has too few candidates,
+-- the lowest priority is asked first
+CREATE TABLE team_partners (
+    team_id         INT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    partner_team_id INT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    priority        INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (team_id, partner_team_id),
+    CHECK (team_id <> partner_team_id)
+);
@@ -0,0 +1,4 @@
+-- reassignment picks the replacement among the author's teammates unless the
+-- team says otherwise
+ALTER TABLE teams
+    ADD COLUMN reassign_pool TEXT NOT NULL DEFAULT 'author_team';
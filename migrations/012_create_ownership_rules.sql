@@ -0,0 +1,16 @@
+-- CODEOWNERS-style rules of a team, rules are applied in rule_id order and
+-- the last matching one owns the path
+CREATE TABLE ownership_rules (
+    rule_id SERIAL PRIMARY KEY,
+    team_id INT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    pattern TEXT NOT NULL
+);
+
+CREATE INDEX ownership_rules_team_idx ON ownership_rules (team_id, rule_id);
+
+CREATE TABLE ownership_rule_owners (
+    rule_id  INT NOT NULL REFERENCES ownership_rules(rule_id) ON DELETE CASCADE,
+    user_id  INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    position INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (rule_id, user_id)
+);
@@ -0,0 +1,3 @@
+-- changed files are kept so that a draft gets owners when it is marked ready
+ALTER TABLE pull_requests
+    ADD COLUMN changed_files TEXT[] NOT NULL DEFAULT '{}';
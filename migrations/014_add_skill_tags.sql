@@ -0,0 +1,6 @@
+-- skill tags of reviewers and the tags a pull request asks its reviewers for
+ALTER TABLE users
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE pull_requests
+    ADD COLUMN required_tags TEXT[] NOT NULL DEFAULT '{}';
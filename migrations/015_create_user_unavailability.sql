@@ -0,0 +1,13 @@
+-- periods when a user takes no reviews; handled_at is set once the open
+-- reviews of the user were moved to someone else
+CREATE TABLE user_unavailability (
+    period_id  SERIAL PRIMARY KEY,
+    user_id    INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at  TIMESTAMP NOT NULL,
+    ends_at    TIMESTAMP NOT NULL,
+    reason     TEXT NOT NULL DEFAULT '',
+    handled_at TIMESTAMP,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX user_unavailability_user_idx ON user_unavailability (user_id, starts_at);
@@ -0,0 +1,3 @@
+-- maximum number of open reviews a user holds at once, NULL means no limit
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INT CHECK (max_open_reviews >= 0);
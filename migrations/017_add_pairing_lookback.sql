@@ -0,0 +1,4 @@
+-- how many days back the least_paired strategy looks for earlier reviews
+-- between an author and a candidate
+ALTER TABLE teams
+    ADD COLUMN pairing_lookback_days INT NOT NULL DEFAULT 30 CHECK (pairing_lookback_days > 0);
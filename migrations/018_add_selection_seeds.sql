@@ -0,0 +1,3 @@
+-- seed of the random reviewer selection behind an assignment, to replay it
+ALTER TABLE pr_events
+    ADD COLUMN selection_seed BIGINT;
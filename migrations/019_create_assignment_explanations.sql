@@ -0,0 +1,24 @@
+-- how reviewers of a pull request were picked: one row per selection and one
+-- per user the selection looked at, excluded_reason is NULL for eligible users
+CREATE TABLE assignment_explanations (
+    explanation_id       SERIAL PRIMARY KEY,
+    pull_request_id      INT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    trigger_type         TEXT NOT NULL,
+    actor                TEXT,
+    strategy             TEXT NOT NULL,
+    selection_seed       BIGINT,
+    replaced_reviewer_id INT REFERENCES users(user_id),
+    created_at           TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX assignment_explanations_pull_request_idx ON assignment_explanations (pull_request_id, explanation_id);
+
+CREATE TABLE assignment_candidates (
+    explanation_id  INT NOT NULL REFERENCES assignment_explanations(explanation_id) ON DELETE CASCADE,
+    user_id         INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    position        INT NOT NULL,
+    excluded_reason TEXT,
+    selected        BOOLEAN NOT NULL DEFAULT FALSE,
+    pool            TEXT,
+    PRIMARY KEY (explanation_id, user_id)
+);
@@ -0,0 +1,27 @@
+-- outbound webhooks: deliveries are written in the transaction of the change
+-- that caused them and sent later by the dispatcher
+CREATE TABLE webhook_subscriptions (
+    subscription_id SERIAL PRIMARY KEY,
+    url             TEXT NOT NULL,
+    secret          TEXT NOT NULL,
+    event_types     TEXT[] NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_deliveries (
+    delivery_id     SERIAL PRIMARY KEY,
+    subscription_id INT NOT NULL REFERENCES webhook_subscriptions(subscription_id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error      TEXT NOT NULL DEFAULT '',
+    delivered_at    TIMESTAMP,
+    dead_at         TIMESTAMP,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at)
+    WHERE delivered_at IS NULL AND dead_at IS NULL;
+CREATE INDEX webhook_deliveries_dead_idx ON webhook_deliveries (dead_at)
+    WHERE dead_at IS NOT NULL;
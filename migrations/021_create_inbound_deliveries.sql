@@ -0,0 +1,7 @@
+-- deliveries of code host webhooks already applied, to skip redeliveries
+CREATE TABLE inbound_deliveries (
+    provider    TEXT NOT NULL,
+    delivery_id TEXT NOT NULL,
+    received_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, delivery_id)
+);
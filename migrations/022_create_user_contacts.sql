@@ -0,0 +1,6 @@
+-- where a user is notified about reviews, an empty value turns the channel off
+CREATE TABLE user_contacts (
+    user_id           INT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    slack_webhook_url TEXT NOT NULL DEFAULT '',
+    email             TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,9 @@
+-- team of the author when the PR was opened, the PR stays with it when the
+-- author later leaves the team
+ALTER TABLE pull_requests
+    ADD COLUMN author_team_id INT REFERENCES teams(team_id) ON DELETE SET NULL;
+
+UPDATE pull_requests AS pr
+SET author_team_id = u.team_id
+FROM users AS u
+WHERE u.user_id = pr.author_id;
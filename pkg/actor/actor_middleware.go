@@ -0,0 +1,63 @@
+package actor
+
+import (
+	"context"
+	"net/http"
+)
+
+type key int
+
+const (
+	ActorKey key = 1
+	AdminKey key = 2
+)
+
+// Header carries the id of the caller. It is not authenticated here: the
+// service has to sit behind a trusted proxy that sets it and drops the value
+// sent by the client, otherwise anyone can act as an admin.
+const Header = "X-Actor-Id"
+
+// FromContext returns the id of the caller who triggered the request or an
+// empty string when it is unknown.
+func FromContext(ctx context.Context) string {
+	actorId, _ := ctx.Value(ActorKey).(string)
+	return actorId
+}
+
+func WithActor(ctx context.Context, actorId string) context.Context {
+	return context.WithValue(ctx, ActorKey, actorId)
+}
+
+// IsAdmin reports whether the caller is one of the configured admins.
+func IsAdmin(ctx context.Context) bool {
+	isAdmin, _ := ctx.Value(AdminKey).(bool)
+	return isAdmin
+}
+
+func WithAdmin(ctx context.Context, isAdmin bool) context.Context {
+	return context.WithValue(ctx, AdminKey, isAdmin)
+}
+
+// AdminMiddleware marks requests of the given actors as admin ones. It has to
+// run after ActorMiddleware.
+func AdminMiddleware(admins []string) func(http.Handler) http.Handler {
+	allowed := make(map[string]bool, len(admins))
+	for _, a := range admins {
+		allowed[a] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			actorId := FromContext(r.Context())
+			ctx := WithAdmin(r.Context(), actorId != "" && allowed[actorId])
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func ActorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithActor(r.Context(), r.Header.Get(Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -2,13 +2,21 @@ package apperrors
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 type HttpError struct {
-	Code    string `json:"code"`
+	Code       string            `json:"code"`
+	Message    string            `json:"message"`
+	Violations []PolicyViolation `json:"violations,omitempty"`
+	Status     int               `json:"-"`
+}
+
+type PolicyViolation struct {
+	Rule    string `json:"rule"`
 	Message string `json:"message"`
-	Status  int    `json:"-"`
 }
 
 var (
@@ -42,13 +50,187 @@ var (
 		Message: "cannot reassign on merged PR",
 		Status:  http.StatusConflict,
 	}
+	HttpErrUnknownStrategy = HttpError{
+		Code:    "UNKNOWN_STRATEGY",
+		Message: "unknown reviewer strategy",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidReviewersCount = HttpError{
+		Code:    "INVALID_REVIEWERS_COUNT",
+		Message: "reviewers count is out of the team limits",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrUnknownReviewsPolicy = HttpError{
+		Code:    "UNKNOWN_REVIEWS_POLICY",
+		Message: "unknown reviews policy",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrPullRequestClosed = HttpError{
+		Code:    "PR_CLOSED",
+		Message: "cannot change reviewers on closed PR",
+		Status:  http.StatusConflict,
+	}
+	HttpErrInvalidRequiredApprovals = HttpError{
+		Code:    "INVALID_REQUIRED_APPROVALS",
+		Message: "required approvals must be between 0 and max reviewers",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrUnknownDecision = HttpError{
+		Code:    "UNKNOWN_DECISION",
+		Message: "unknown review decision",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrReviewerNotAssigned = HttpError{
+		Code:    "NOT_ASSIGNED",
+		Message: "reviewer is not assigned to this PR",
+		Status:  http.StatusConflict,
+	}
+	HttpErrMergePolicyViolated = HttpError{
+		Code:    "MERGE_POLICY_VIOLATED",
+		Message: "PR does not satisfy the team merge policy",
+		Status:  http.StatusConflict,
+	}
+	HttpErrForbidden = HttpError{
+		Code:    "FORBIDDEN",
+		Message: "action is allowed for admins only",
+		Status:  http.StatusForbidden,
+	}
+	HttpErrPullRequestDraft = HttpError{
+		Code:    "PR_DRAFT",
+		Message: "cannot change reviewers on draft PR",
+		Status:  http.StatusConflict,
+	}
+	HttpErrReviewerIsAuthor = HttpError{
+		Code:    "REVIEWER_IS_AUTHOR",
+		Message: "author cannot review own PR",
+		Status:  http.StatusConflict,
+	}
+	HttpErrReviewerAlreadyAssigned = HttpError{
+		Code:    "ALREADY_ASSIGNED",
+		Message: "reviewer is already assigned to this PR",
+		Status:  http.StatusConflict,
+	}
+	HttpErrUnknownReassignPool = HttpError{
+		Code:    "UNKNOWN_REASSIGN_POOL",
+		Message: "unknown reassign pool",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidPairingLookback = HttpError{
+		Code:    "INVALID_LOOKBACK",
+		Message: "pairing lookback must be at least one day",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidCapacity = HttpError{
+		Code:    "INVALID_CAPACITY",
+		Message: "max open reviews must not be negative",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidPeriod = HttpError{
+		Code:    "INVALID_PERIOD",
+		Message: "period must end after it starts",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidWebhook = HttpError{
+		Code:    "INVALID_WEBHOOK",
+		Message: "webhook needs an http(s) url, a secret and known event types",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidContact = HttpError{
+		Code:    "INVALID_CONTACT",
+		Message: "contact needs an http(s) slack webhook url and a valid email",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidSignature = HttpError{
+		Code:    "INVALID_SIGNATURE",
+		Message: "webhook signature does not match",
+		Status:  http.StatusUnauthorized,
+	}
+	HttpErrInvalidTag = HttpError{
+		Code:    "INVALID_TAG",
+		Message: "tag must be a non-empty word",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidOwnershipPattern = HttpError{
+		Code:    "INVALID_PATTERN",
+		Message: "invalid ownership pattern",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidPartnerTeam = HttpError{
+		Code:    "INVALID_PARTNER_TEAM",
+		Message: "team cannot be a partner of itself",
+		Status:  http.StatusBadRequest,
+	}
+	HttpErrInvalidStatusTransition = HttpError{
+		Code:    "INVALID_STATUS_TRANSITION",
+		Message: "PR status can't be changed this way",
+		Status:  http.StatusConflict,
+	}
 )
 
 var (
-	ErrTeamExists        = errors.New("team_name already exists")
-	ErrServerError       = errors.New("server error")
-	ErrParseData         = errors.New("can't parse data from json")
-	ErrResourceNotFound  = errors.New("resource not found")
-	ErrPullRequestExists = errors.New("pr id already exists")
-	ErrPullRequestMerged = errors.New("cannot reassign on merged PR")
+	ErrTeamExists            = errors.New("team_name already exists")
+	ErrServerError           = errors.New("server error")
+	ErrParseData             = errors.New("can't parse data from json")
+	ErrResourceNotFound      = errors.New("resource not found")
+	ErrPullRequestExists     = errors.New("pr id already exists")
+	ErrPullRequestMerged     = errors.New("cannot reassign on merged PR")
+	ErrUnknownStrategy       = errors.New("unknown reviewer strategy")
+	ErrInvalidReviewersCount = errors.New("reviewers count is out of the team limits")
+	ErrUnknownReviewsPolicy  = errors.New("unknown reviews policy")
+	ErrPullRequestClosed     = errors.New("cannot change reviewers on closed PR")
+	ErrPullRequestDraft      = errors.New("cannot change reviewers on draft PR")
+
+	ErrReviewerIsAuthor        = errors.New("author cannot review own PR")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer is already assigned to this PR")
+	ErrInvalidPartnerTeam      = errors.New("team cannot be a partner of itself")
+	ErrUnknownReassignPool     = errors.New("unknown reassign pool")
+	ErrInvalidOwnershipPattern = errors.New("invalid ownership pattern")
+	ErrInvalidTag              = errors.New("tag must be a non-empty word")
+	ErrInvalidPeriod           = errors.New("period must end after it starts")
+	ErrInvalidCapacity         = errors.New("max open reviews must not be negative")
+	ErrInvalidPairingLookback  = errors.New("pairing lookback must be at least one day")
+	ErrInvalidWebhook          = errors.New("webhook needs an http(s) url, a secret and known event types")
+	ErrInvalidSignature        = errors.New("webhook signature does not match")
+	ErrInvalidContact          = errors.New("contact needs an http(s) slack webhook url and a valid email")
+
+	ErrInvalidRequiredApprovals = errors.New("required approvals must be between 0 and max reviewers")
+	ErrUnknownDecision          = errors.New("unknown review decision")
+	ErrReviewerNotAssigned      = errors.New("reviewer is not assigned to this PR")
+	ErrMergePolicyViolated      = errors.New("PR does not satisfy the team merge policy")
+	ErrForbidden                = errors.New("action is allowed for admins only")
+
+	ErrInvalidStatusTransition = errors.New("PR status can't be changed this way")
 )
+
+// StatusTransitionError is returned when a PR can't move from its current
+// status to the requested one. It matches ErrInvalidStatusTransition.
+type StatusTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("cannot move PR from %s to %s", e.From, e.To)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrInvalidStatusTransition
+}
+
+// MergePolicyError lists every merge rule the PR breaks. It matches
+// ErrMergePolicyViolated.
+type MergePolicyError struct {
+	Violations []PolicyViolation
+}
+
+func (e *MergePolicyError) Error() string {
+	rules := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		rules = append(rules, v.Rule)
+	}
+	return fmt.Sprintf("merge policy violated: %s", strings.Join(rules, ", "))
+}
+
+func (e *MergePolicyError) Unwrap() error {
+	return ErrMergePolicyViolated
+}
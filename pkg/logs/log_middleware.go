@@ -68,5 +68,19 @@ func LoggerMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// StartContext collects logs of a call made outside of the HTTP router; the
+// returned func prints them under path, as LoggerMiddleware does.
+func StartContext(ctx context.Context, path string) (context.Context, func()) {
+	start := time.Now()
+
+	ctx = context.WithValue(ctx, LogsKey, &CtxLog{
+		Data: make([]LogEntry, 0, 4),
+	})
+
+	return ctx, func() {
+		logContext(ctx, path, start)
+	}
+}
+
 //TODO: реализовать контекст с логами для отдельных горутин, не внтури request.Context
 //TODO: может быть реализовать перенаправление логов кодом